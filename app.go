@@ -1,44 +1,206 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"github.com/Mufidzz/bareksa-test/internal/news"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/internal/repository/memory"
 	"github.com/Mufidzz/bareksa-test/internal/repository/postgre"
 	redisRepository "github.com/Mufidzz/bareksa-test/internal/repository/redis"
+	"github.com/Mufidzz/bareksa-test/migrations"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"github.com/Mufidzz/bareksa-test/pkg/config"
+	"github.com/Mufidzz/bareksa-test/pkg/migration"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/go-redis/redis/v8"
 	"log"
+	"net/http"
+	"os"
+	"strconv"
 )
 
 func main() {
-	postgreRepo, err := postgre.New("user=tes_bareksa password=tes_bareksa dbname=tes_bareksa host=139.162.36.125 sslmode=disable")
+	configPath := flag.String("config", os.Getenv(config.ENV_CONFIG_PATH), "path to .yaml, .yml or .json config file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
 	if err != nil {
-		log.Printf("[DB Init] error initialize database, trace %v", err)
+		log.Fatalf("[Config Init] error loading configuration, trace %v", err)
+	}
+
+	if flag.Arg(0) == "migrate" {
+		err = RunMigration(cfg.Postgre.Master, flag.Args()[1:])
+		if err != nil {
+			log.Fatalf("[Migration] %v", err)
+		}
+		return
 	}
 
-	redisRepo, err := redisRepository.New(redis.Options{
-		Addr:     "localhost:6379",
-		Password: "",
-		DB:       0,
+	postgreRepo, err := postgre.New(cfg.Postgre.Master, cfg.Postgre.Slaves, postgre.ReadOptions{
+		SlaveRetryInterval: cfg.Postgre.SlaveRetryInterval.Std(),
 	})
 	if err != nil {
-		log.Printf("[DB Init] error initialize redis, trace %v", err)
+		log.Printf("[DB Init] error initialize database, trace %v", err)
+	}
+
+	guard, err := NewAuthGuard(cfg.Auth)
+	if err != nil {
+		log.Fatalf("[Auth Init] error initialize authentication, trace %v", err)
+	}
+
+	StartREST(cfg, postgreRepo, NewCacheBackend(cfg), guard)
+}
+
+// NewAuthGuard build guard verifying bearer token with configured keys, every route is open when auth is disabled
+func NewAuthGuard(cfg config.AuthConfig) (*auth.Guard, error) {
+	if !cfg.Enabled {
+		log.Printf("[Auth Init] authentication disabled, every route is open")
+		return auth.NewGuard(nil, true), nil
+	}
+
+	options := auth.VerifierOptions{
+		HMACSecret: []byte(cfg.HMACSecret),
+		Issuer:     cfg.Issuer,
+		Audience:   cfg.Audience,
+		Leeway:     cfg.Leeway.Std(),
+	}
+
+	if cfg.RSAPublicKeyFile != "" {
+		raw, err := os.ReadFile(cfg.RSAPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		options.RSAPublicKey, err = auth.ParseRSAPublicKey(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing %s, %v", cfg.RSAPublicKeyFile, err)
+		}
+	}
+
+	verifier, err := auth.NewVerifier(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewGuard(verifier, cfg.AnonymousRead), nil
+}
+
+// NewCacheBackend build cache selected by cache.backend. Redis is wrapped by circuit breaker,
+// so unreachable Redis only cost cache misses, and it is used once it recover
+func NewCacheBackend(cfg config.Config) cache.Backend {
+	switch cfg.Cache.Backend {
+	case config.CACHE_BACKEND_MEMORY:
+		return memory.New(cfg.Cache.MemoryMaxEntries)
+	case config.CACHE_BACKEND_NONE:
+		return &cache.Noop{}
+	}
+
+	redisOptions := redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	}
+
+	redisRepo, err := redisRepository.New(redisOptions)
+	if err != nil {
+		log.Printf("[DB Init] error initialize redis, cache bypassed until it recover, trace %v", err)
+
+		breaker := cache.NewBreaker(redisRepository.NewFromObject(redis.NewClient(&redisOptions)), cfg.Cache.BreakerFailures, cfg.Cache.BreakerCooldown.Std())
+		breaker.Trip()
+		return breaker
 	}
 
-	StartREST(postgreRepo, redisRepo)
+	return cache.NewBreaker(redisRepo, cfg.Cache.BreakerFailures, cfg.Cache.BreakerCooldown.Std())
 }
 
-func StartREST(pg *postgre.Postgre, redisRepo *redisRepository.Redis) {
+func StartREST(cfg config.Config, pg *postgre.Postgre, cacheBackend cache.Backend, guard *auth.Guard) {
+	serverConfig := cfg.Server
+
 	router := gin.Default()
 	router.Use(cors.New(cors.Config{
-		AllowMethods:     []string{"PUT", "POST", "GET", "DELETE"},
-		AllowHeaders:     []string{"Origin"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true,
-		AllowAllOrigins:  true,
+		AllowAllOrigins:  serverConfig.CORS.AllowAllOrigins,
+		AllowOrigins:     serverConfig.CORS.AllowOrigins,
+		AllowMethods:     serverConfig.CORS.AllowMethods,
+		AllowHeaders:     serverConfig.CORS.AllowHeaders,
+		ExposeHeaders:    serverConfig.CORS.ExposeHeaders,
+		AllowCredentials: serverConfig.CORS.AllowCredentials,
 	}))
 
-	news.StartHTTP(router, pg, redisRepo)
+	newsDomain := news.StartHTTP(router, pg, cacheBackend, guard, usecase.Options{
+		LegacyAssocNames:     cfg.News.LegacyAssocNames,
+		StaleWhileRevalidate: cfg.News.StaleWhileRevalidate,
+	})
+	newsDomain.StartPurgeDeletedNews(context.Background(), cfg.News.PurgeInterval.Std(), cfg.News.DeletedRetention.Std())
+	newsDomain.StartNewsSchedule(context.Background(), cfg.News.ScheduleInterval.Std())
+
+	router.GET("/cache/stats", guard.Require(auth.ROLE_ADMIN), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, response.SuccessResponse{
+			Success: true,
+			Message: "Success Getting Cache Stats",
+			Data:    cacheBackend.Stats(),
+		})
+	})
+
+	router.Run(serverConfig.Address)
+}
+
+// RunMigration handle `migrate up`, `migrate down [steps]` and `migrate status` against Postgre master
+func RunMigration(masterConnectionString string, args []string) error {
+	pgDB, err := postgre.InitPostgreDB(masterConnectionString)
+	if err != nil {
+		return fmt.Errorf("error initialize database, trace %v", err)
+	}
+	defer pgDB.Master.Close()
+
+	migrator, err := migration.New(pgDB.Master, migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	command := migration.DIRECTION_UP
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case migration.DIRECTION_UP:
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		log.Printf("[Migration] applied %d migration %v", len(applied), applied)
+	case migration.DIRECTION_DOWN:
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return fmt.Errorf("invalid down steps %q", args[1])
+			}
+		}
+
+		reverted, err := migrator.Down(ctx, steps)
+		if err != nil {
+			return err
+		}
+		log.Printf("[Migration] reverted %d migration %v", len(reverted), reverted)
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range status {
+			log.Printf("[Migration] %04d_%s applied %v", s.Version, s.Name, s.Applied)
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q, use up, down [steps] or status", command)
+	}
 
-	router.Run(":4456")
+	return nil
 }
@@ -0,0 +1,25 @@
+package rest
+
+import (
+	"errors"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// respondForbidden answer 403 when usecase refused the principal, ex. writer changing news of other writer.
+// It return false and write nothing for every other error
+func respondForbidden(ctx *gin.Context, err error) bool {
+	if !errors.Is(err, auth.ErrForbidden) {
+		return false
+	}
+
+	ctx.JSON(http.StatusForbidden, response.ErrorResponse{
+		Success: false,
+		Message: "Forbidden, only the writer who created the news or an editor can change it",
+		Type:    0,
+		Data:    nil,
+	})
+	return true
+}
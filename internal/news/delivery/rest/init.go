@@ -1,11 +1,16 @@
 package rest
 
-import "github.com/gin-gonic/gin"
+import (
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/gin-gonic/gin"
+)
 
 type Usecases struct {
 	NewsDataUC
 	NewsTopicDataUC
 	NewsTagDataUC
+	NewsAuthorDataUC
 }
 
 type HTTPHandler struct {
@@ -18,48 +23,100 @@ func NewHTTP(
 	newsDataUC NewsDataUC,
 	newsTopicDataUC NewsTopicDataUC,
 	newsTagDataUC NewsTagDataUC,
+	newsAuthorDataUC NewsAuthorDataUC,
 
 ) *HTTPHandler {
 	return &HTTPHandler{
 		router: router,
 		usecases: Usecases{
-			NewsDataUC:      newsDataUC,
-			NewsTopicDataUC: newsTopicDataUC,
-			NewsTagDataUC:   newsTagDataUC,
+			NewsDataUC:       newsDataUC,
+			NewsTopicDataUC:  newsTopicDataUC,
+			NewsTagDataUC:    newsTagDataUC,
+			NewsAuthorDataUC: newsAuthorDataUC,
 		},
 	}
 }
 
-func (handler *HTTPHandler) SetRoutes() {
+// SetRoutes register news routes, reads need ROLE_READER, news writes, submission for review, workflow history and revisions ROLE_WRITER,
+// and deletion, restoration, review, publication and topic, tag and author changes ROLE_EDITOR
+func (handler *HTTPHandler) SetRoutes(guard *auth.Guard) {
 	router := handler.router
-	assign := router.Group("/assign")
+	reader := guard.Require(auth.ROLE_READER)
+	writer := guard.Require(auth.ROLE_WRITER)
+	editor := guard.Require(auth.ROLE_EDITOR)
+
+	assign := router.Group("/assign", writer)
 	{
 		assign.POST("/news/news-topic", handler.HandleAssignNewsWithNewsTopics)
 		assign.POST("/news/news-tag", handler.HandleAssignNewsWithNewsTags)
+		assign.PUT("/news/news-topic", handler.HandleReassignNewsWithNewsTopics)
+		assign.PUT("/news/news-tag", handler.HandleReassignNewsWithNewsTags)
+		assign.POST("/news/news-author", handler.HandleAssignNewsWithNewsAuthors)
+		assign.PUT("/news/news-author", handler.HandleReassignNewsWithNewsAuthors)
 	}
 
-	news := router.Group("/news")
+	news := router.Group("/news", reader)
 	{
 		news.GET("/", handler.HandleGetNews)
+		news.GET("/search", handler.HandleSearchNews)
 		news.GET("/:newsId", handler.HandleGetSingleNews)
-		news.PUT("/:newsId", handler.HandleUpdateSingleNews)
-		news.POST("/", handler.HandleCreateSingleNews)
-		news.DELETE("/:newsId", handler.HandleDeleteSingleNews)
 	}
 
-	newsTopic := router.Group("/news-topic")
+	newsWrite := router.Group("/news", writer)
+	{
+		newsWrite.PUT("/:newsId", handler.HandleUpdateSingleNews)
+		newsWrite.POST("/", handler.HandleCreateSingleNews)
+		newsWrite.GET("/:newsId/transitions", handler.HandleGetNewsTransitions)
+		newsWrite.POST("/:newsId/submit", handler.HandleTransitionNews(presentation.NEWS_TRANSITION_SUBMIT))
+		newsWrite.GET("/:newsId/revisions", handler.HandleGetNewsRevisions)
+		newsWrite.GET("/:newsId/revisions/:revision", handler.HandleGetNewsRevision)
+		newsWrite.GET("/:newsId/revisions/:revision/diff/:otherRevision", handler.HandleDiffNewsRevisions)
+		newsWrite.POST("/:newsId/revisions/:revision/restore", handler.HandleRestoreNewsRevision)
+	}
+
+	newsEdit := router.Group("/news", editor)
+	{
+		newsEdit.DELETE("/:newsId", handler.HandleDeleteSingleNews)
+		newsEdit.POST("/:newsId/restore", handler.HandleRestoreSingleNews)
+		newsEdit.POST("/:newsId/reject", handler.HandleTransitionNews(presentation.NEWS_TRANSITION_REJECT))
+		newsEdit.POST("/:newsId/approve", handler.HandleTransitionNews(presentation.NEWS_TRANSITION_APPROVE))
+		newsEdit.POST("/:newsId/publish", handler.HandleTransitionNews(presentation.NEWS_TRANSITION_PUBLISH))
+		newsEdit.POST("/:newsId/unpublish", handler.HandleTransitionNews(presentation.NEWS_TRANSITION_UNPUBLISH))
+	}
+
+	newsTopic := router.Group("/news-topic", reader)
 	{
 		newsTopic.GET("/", handler.HandleGetNewsTopic)
-		newsTopic.PUT("/", handler.HandleUpdateNewsTopic)
-		newsTopic.POST("/", handler.HandleCreateNewsTopic)
-		newsTopic.DELETE("/", handler.HandleDeleteNewsTopic)
 	}
 
-	newsTag := router.Group("/news-tag")
+	newsTopicEdit := router.Group("/news-topic", editor)
+	{
+		newsTopicEdit.PUT("/", handler.HandleUpdateNewsTopic)
+		newsTopicEdit.POST("/", handler.HandleCreateNewsTopic)
+		newsTopicEdit.DELETE("/", handler.HandleDeleteNewsTopic)
+	}
+
+	newsTag := router.Group("/news-tag", reader)
 	{
 		newsTag.GET("/", handler.HandleGetNewsTag)
-		newsTag.PUT("/", handler.HandleUpdateNewsTag)
-		newsTag.POST("/", handler.HandleCreateNewsTag)
-		newsTag.DELETE("/", handler.HandleDeleteNewsTag)
+	}
+
+	newsTagEdit := router.Group("/news-tag", editor)
+	{
+		newsTagEdit.PUT("/", handler.HandleUpdateNewsTag)
+		newsTagEdit.POST("/", handler.HandleCreateNewsTag)
+		newsTagEdit.DELETE("/", handler.HandleDeleteNewsTag)
+	}
+
+	newsAuthor := router.Group("/news-author", reader)
+	{
+		newsAuthor.GET("/", handler.HandleGetNewsAuthor)
+	}
+
+	newsAuthorEdit := router.Group("/news-author", editor)
+	{
+		newsAuthorEdit.PUT("/", handler.HandleUpdateNewsAuthor)
+		newsAuthorEdit.POST("/", handler.HandleCreateNewsAuthor)
+		newsAuthorEdit.DELETE("/", handler.HandleDeleteNewsAuthor)
 	}
 }
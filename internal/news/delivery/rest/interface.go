@@ -1,31 +1,52 @@
 package rest
 
 import (
+	"context"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
 )
 
 type NewsDataUC interface {
-	CreateSingleNews(newNews presentation.CreateNewsRequest) error
-	UpdateSingleNews(updatedNews presentation.UpdateNewsRequest) error
-	DeleteSingleNews(newsId int) error
-	GetSingleNews(ctx *gin.Context, newsId int) (presentation.GetNewsResponse, error)
-	GetNews(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsResponse, err error)
-
-	AssignNewsWithNewsTopic(in presentation.CreateNewsTopicsAssoc) error
-	AssignNewsWithNewsTag(in presentation.CreateNewsTagsAssoc) error
+	CreateSingleNews(ctx context.Context, newNews presentation.CreateNewsRequest) error
+	UpdateSingleNews(ctx context.Context, updatedNews presentation.UpdateNewsRequest) error
+	DeleteSingleNews(ctx context.Context, newsId int) error
+	RestoreSingleNews(ctx context.Context, newsId int) error
+	GetSingleNews(ctx context.Context, newsId int) (presentation.GetNewsResponse, error)
+	GetNews(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsListResponse, err error)
+	SearchNews(ctx context.Context, searchQuery, paginationString, filterString string) (res presentation.SearchNewsListResponse, err error)
+
+	AssignNewsWithNewsTopic(ctx context.Context, in presentation.CreateNewsTopicsAssoc) error
+	AssignNewsWithNewsTag(ctx context.Context, in presentation.CreateNewsTagsAssoc) error
+	ReassignNewsTopics(ctx context.Context, in presentation.CreateNewsTopicsAssoc) error
+	ReassignNewsTags(ctx context.Context, in presentation.CreateNewsTagsAssoc) error
+	AssignNewsWithNewsAuthor(ctx context.Context, in presentation.CreateNewsAuthorsAssoc) error
+	ReassignNewsAuthors(ctx context.Context, in presentation.CreateNewsAuthorsAssoc) error
+
+	TransitionNews(ctx context.Context, newsID int, transition string) error
+	GetNewsTransitions(ctx context.Context, newsID int) ([]presentation.NewsStatusTransition, error)
+
+	GetNewsRevisions(ctx context.Context, newsID int) ([]presentation.NewsRevision, error)
+	GetNewsRevision(ctx context.Context, newsID, revision int) (presentation.NewsRevision, error)
+	DiffNewsRevisions(ctx context.Context, newsID, from, to int) (presentation.NewsRevisionDiff, error)
+	RestoreNewsRevision(ctx context.Context, newsID, revision int) error
 }
 
 type NewsTopicDataUC interface {
-	CreateNewsTopics(in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error)
-	DeleteNewsTopics(newsTopicID []int) (deletedID []int, err error)
-	UpdateNewsTopics(newNewsTopics []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error)
-	GetNewsTopics(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsTopicsResponse, err error)
+	CreateNewsTopics(ctx context.Context, in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error)
+	DeleteNewsTopics(ctx context.Context, newsTopicID []int) (deletedID []int, err error)
+	UpdateNewsTopics(ctx context.Context, newNewsTopics []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error)
+	GetNewsTopics(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsTopicsListResponse, err error)
 }
 
 type NewsTagDataUC interface {
-	CreateNewsTags(in []presentation.CreateNewsTagsRequest) (insertedID []int, err error)
-	DeleteNewsTags(newsTopicID []int) (deletedID []int, err error)
-	UpdateNewsTags(newNewsTags []presentation.UpdateNewsTagsRequest) (updatedID []int, err error)
-	GetNewsTags(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsTagsResponse, err error)
+	CreateNewsTags(ctx context.Context, in []presentation.CreateNewsTagsRequest) (insertedID []int, err error)
+	DeleteNewsTags(ctx context.Context, newsTopicID []int) (deletedID []int, err error)
+	UpdateNewsTags(ctx context.Context, newNewsTags []presentation.UpdateNewsTagsRequest) (updatedID []int, err error)
+	GetNewsTags(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsTagsListResponse, err error)
+}
+
+type NewsAuthorDataUC interface {
+	CreateNewsAuthors(ctx context.Context, in []presentation.CreateNewsAuthorsRequest) (insertedID []int, err error)
+	DeleteNewsAuthors(ctx context.Context, newsAuthorID []int) (deletedID []int, err error)
+	UpdateNewsAuthors(ctx context.Context, newNewsAuthors []presentation.UpdateNewsAuthorsRequest) (updatedID []int, err error)
+	GetNewsAuthors(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsAuthorsListResponse, err error)
 }
@@ -0,0 +1,191 @@
+package rest
+
+import (
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+func (handler *HTTPHandler) HandleGetNewsAuthor(ctx *gin.Context) {
+	paginationString, filterString, err := bindListQuery(ctx, &presentation.Pagination{}, &presentation.NewsAuthorsFilter{})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Query, Please check filter and pagination query are valid",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	sortString := ctx.Query("sort")
+
+	news, err := handler.usecases.GetNewsAuthors(ctx.Request.Context(), paginationString, filterString, sortString)
+	if err != nil {
+		if respondInvalidQuery(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleGetNews",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Get News",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Getting News",
+		Data:    news.Data,
+		Meta:    news.Meta,
+	})
+}
+
+func (handler *HTTPHandler) HandleUpdateNewsAuthor(ctx *gin.Context) {
+	var newNewsAuthor []presentation.UpdateNewsAuthorsRequest
+
+	err := ctx.BindJSON(&newNewsAuthor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Binding JSON",
+			Type:    0,
+			Data:    newNewsAuthor,
+		})
+		return
+	}
+
+	updatedIDs, err := handler.usecases.UpdateNewsAuthors(ctx.Request.Context(), newNewsAuthor)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleUpdateSingleNews",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Update News Authors",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Updated News Authors",
+		Data: gin.H{
+			"updated_id": updatedIDs,
+		},
+	})
+}
+
+func (handler *HTTPHandler) HandleCreateNewsAuthor(ctx *gin.Context) {
+	var newNewsAuthor []presentation.CreateNewsAuthorsRequest
+
+	err := ctx.BindJSON(&newNewsAuthor)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Binding JSON",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	_, err = handler.usecases.CreateNewsAuthors(ctx.Request.Context(), newNewsAuthor)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleCreateNewsAuthor",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Create News Authors",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+	ctx.JSON(http.StatusNoContent, "")
+}
+
+func (handler *HTTPHandler) HandleDeleteNewsAuthor(ctx *gin.Context) {
+	authorIds := ctx.QueryArray("id")
+	var intAuthorIds []int
+
+	if len(authorIds) <= 0 {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "ID Must more than 1",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	for _, v := range authorIds {
+		_t, err := strconv.Atoi(v)
+
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing Author ID, Please check all Author ID is valid Number",
+				Type:    0,
+				Data:    authorIds,
+			})
+			return
+		}
+
+		intAuthorIds = append(intAuthorIds, _t)
+	}
+
+	deletedIds, err := handler.usecases.DeleteNewsAuthors(ctx.Request.Context(), intAuthorIds)
+
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleDeleteSingleNews",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Delete News Author",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Delete News Authors",
+		Data: gin.H{
+			"deleted_id": deletedIds,
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package rest
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+type MockNewsAuthorDataUC struct {
+	createNewsAuthors createNewsAuthors
+	deleteNewsAuthors deleteNewsAuthors
+	updateNewsAuthors updateNewsAuthors
+	getNewsAuthors    getNewsAuthors
+}
+
+type createNewsAuthors struct {
+	insertedID []int
+	err        error
+}
+
+type deleteNewsAuthors struct {
+	deletedID []int
+	err       error
+}
+
+type updateNewsAuthors struct {
+	updatedID []int
+	err       error
+}
+
+type getNewsAuthors struct {
+	res presentation.GetNewsAuthorsListResponse
+	err error
+}
+
+func (mntduc *MockNewsAuthorDataUC) CreateNewsAuthors(ctx context.Context, in []presentation.CreateNewsAuthorsRequest) (insertedID []int, err error) {
+	return mntduc.createNewsAuthors.insertedID, mntduc.createNewsAuthors.err
+}
+func (mntduc *MockNewsAuthorDataUC) DeleteNewsAuthors(ctx context.Context, newsAuthorID []int) (deletedID []int, err error) {
+	return mntduc.deleteNewsAuthors.deletedID, mntduc.deleteNewsAuthors.err
+}
+func (mntduc *MockNewsAuthorDataUC) UpdateNewsAuthors(ctx context.Context, newNewsAuthors []presentation.UpdateNewsAuthorsRequest) (updatedID []int, err error) {
+	return mntduc.updateNewsAuthors.updatedID, mntduc.updateNewsAuthors.err
+}
+func (mntduc *MockNewsAuthorDataUC) GetNewsAuthors(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsAuthorsListResponse, err error) {
+	return mntduc.getNewsAuthors.res, mntduc.getNewsAuthors.err
+}
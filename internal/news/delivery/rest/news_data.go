@@ -1,16 +1,30 @@
 package rest
 
 import (
+	"errors"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
 	"github.com/Mufidzz/bareksa-test/pkg/logger"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/presentation"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 func (handler *HTTPHandler) HandleGetNews(ctx *gin.Context) {
-	filterString, paginationString := ctx.Query("filter"), ctx.Query("pagination")
+	paginationString, filterString, err := bindListQuery(ctx, &presentation.Pagination{}, &presentation.NewsFilter{})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Query, Please check filter and pagination query are valid",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	sortString := ctx.Query("sort")
 
 	if paginationString == "" {
 		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
@@ -22,8 +36,12 @@ func (handler *HTTPHandler) HandleGetNews(ctx *gin.Context) {
 		return
 	}
 
-	news, err := handler.usecases.GetNews(ctx, paginationString, filterString)
+	news, err := handler.usecases.GetNews(ctx.Request.Context(), paginationString, filterString, sortString)
 	if err != nil {
+		if respondInvalidQuery(ctx, err) {
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -44,7 +62,73 @@ func (handler *HTTPHandler) HandleGetNews(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
 		Message: "Success Getting News",
-		Data:    news,
+		Data:    news.Data,
+		Meta:    news.Meta,
+	})
+}
+
+func (handler *HTTPHandler) HandleSearchNews(ctx *gin.Context) {
+	searchQuery := strings.TrimSpace(ctx.Query("q"))
+
+	if searchQuery == "" {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Search Query Cannot be Blank, use q URL Query to assign",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	paginationString, filterString, err := bindListQuery(ctx, &presentation.Pagination{}, &presentation.NewsFilter{})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Query, Please check filter and pagination query are valid",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	if paginationString == "" {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Pagination Cannot be Blank, use URL Query to assign",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	news, err := handler.usecases.SearchNews(ctx.Request.Context(), searchQuery, paginationString, filterString)
+	if err != nil {
+		if respondInvalidQuery(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleSearchNews",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Search News",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Searching News",
+		Data:    news.Data,
+		Meta:    news.Meta,
 	})
 }
 
@@ -79,8 +163,18 @@ func (handler *HTTPHandler) HandleGetSingleNews(ctx *gin.Context) {
 		return
 	}
 
-	news, err := handler.usecases.GetSingleNews(ctx, intNewsID)
+	news, err := handler.usecases.GetSingleNews(ctx.Request.Context(), intNewsID)
 	if err != nil {
+		if errors.Is(err, usecase.ErrNewsNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{
+				Success: false,
+				Message: "News Not Found",
+				Type:    0,
+				Data:    nil,
+			})
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -150,9 +244,23 @@ func (handler *HTTPHandler) HandleUpdateSingleNews(ctx *gin.Context) {
 	}
 
 	newNews.ID = intNewsID
-	err = handler.usecases.UpdateSingleNews(newNews)
+	err = handler.usecases.UpdateSingleNews(ctx.Request.Context(), newNews)
 
 	if err != nil {
+		if respondForbidden(ctx, err) || respondIllegalTransition(ctx, err, "Status Can not be Changed by Update, use workflow endpoints") {
+			return
+		}
+
+		if errors.Is(err, usecase.ErrNewsNotFound) {
+			ctx.JSON(http.StatusNotFound, response.ErrorResponse{
+				Success: false,
+				Message: "News Not Found",
+				Type:    0,
+				Data:    nil,
+			})
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -187,8 +295,12 @@ func (handler *HTTPHandler) HandleCreateSingleNews(ctx *gin.Context) {
 		return
 	}
 
-	err = handler.usecases.CreateSingleNews(newNews)
+	err = handler.usecases.CreateSingleNews(ctx.Request.Context(), newNews)
 	if err != nil {
+		if respondIllegalTransition(ctx, err, "News is Created as Draft, use workflow endpoints to change status") {
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -239,7 +351,7 @@ func (handler *HTTPHandler) HandleDeleteSingleNews(ctx *gin.Context) {
 		return
 	}
 
-	err = handler.usecases.DeleteSingleNews(intNewsID)
+	err = handler.usecases.DeleteSingleNews(ctx.Request.Context(), intNewsID)
 
 	if err != nil {
 		logger.Error(response.InternalError{
@@ -262,6 +374,59 @@ func (handler *HTTPHandler) HandleDeleteSingleNews(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, "")
 }
 
+func (handler *HTTPHandler) HandleRestoreSingleNews(ctx *gin.Context) {
+	newsID := ctx.Param("newsId")
+	if newsID == "" {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "News ID Cannot be Blank, use URL Parameter to assign",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	intNewsID, err := strconv.Atoi(newsID)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleRestoreSingleNews",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing News ID, Please check news id is valid Number",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	err = handler.usecases.RestoreSingleNews(ctx.Request.Context(), intNewsID)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleRestoreSingleNews",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Restore Single News",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "")
+}
+
 func (handler *HTTPHandler) HandleAssignNewsWithNewsTopics(ctx *gin.Context) {
 	var newsTopicAssoc presentation.CreateNewsTopicsAssoc
 
@@ -276,8 +441,12 @@ func (handler *HTTPHandler) HandleAssignNewsWithNewsTopics(ctx *gin.Context) {
 		return
 	}
 
-	err = handler.usecases.AssignNewsWithNewsTopic(newsTopicAssoc)
+	err = handler.usecases.AssignNewsWithNewsTopic(ctx.Request.Context(), newsTopicAssoc)
 	if err != nil {
+		if respondForbidden(ctx, err) {
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -311,8 +480,12 @@ func (handler *HTTPHandler) HandleAssignNewsWithNewsTags(ctx *gin.Context) {
 		return
 	}
 
-	err = handler.usecases.AssignNewsWithNewsTag(newsTagAssoc)
+	err = handler.usecases.AssignNewsWithNewsTag(ctx.Request.Context(), newsTagAssoc)
 	if err != nil {
+		if respondForbidden(ctx, err) {
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -332,3 +505,159 @@ func (handler *HTTPHandler) HandleAssignNewsWithNewsTags(ctx *gin.Context) {
 	ctx.JSON(http.StatusNoContent, "")
 
 }
+
+func (handler *HTTPHandler) HandleReassignNewsWithNewsTopics(ctx *gin.Context) {
+	var newsTopicAssoc presentation.CreateNewsTopicsAssoc
+
+	err := ctx.BindJSON(&newsTopicAssoc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Binding JSON",
+			Type:    0,
+			Data:    newsTopicAssoc,
+		})
+		return
+	}
+
+	err = handler.usecases.ReassignNewsTopics(ctx.Request.Context(), newsTopicAssoc)
+	if err != nil {
+		if respondForbidden(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleReassignNewsWithNewsTopics",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Reassign News With News Topic",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+	ctx.JSON(http.StatusNoContent, "")
+}
+
+func (handler *HTTPHandler) HandleReassignNewsWithNewsTags(ctx *gin.Context) {
+	var newsTagAssoc presentation.CreateNewsTagsAssoc
+
+	err := ctx.BindJSON(&newsTagAssoc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Binding JSON",
+			Type:    0,
+			Data:    newsTagAssoc,
+		})
+		return
+	}
+
+	err = handler.usecases.ReassignNewsTags(ctx.Request.Context(), newsTagAssoc)
+	if err != nil {
+		if respondForbidden(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleReassignNewsWithNewsTags",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Reassign News With News Tags",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+	ctx.JSON(http.StatusNoContent, "")
+}
+
+func (handler *HTTPHandler) HandleAssignNewsWithNewsAuthors(ctx *gin.Context) {
+	var newsAuthorAssoc presentation.CreateNewsAuthorsAssoc
+
+	err := ctx.BindJSON(&newsAuthorAssoc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Binding JSON",
+			Type:    0,
+			Data:    newsAuthorAssoc,
+		})
+		return
+	}
+
+	err = handler.usecases.AssignNewsWithNewsAuthor(ctx.Request.Context(), newsAuthorAssoc)
+	if err != nil {
+		if respondForbidden(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleAssignNewsWithNewsAuthors",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Assign News With News Authors",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+	ctx.JSON(http.StatusNoContent, "")
+}
+
+func (handler *HTTPHandler) HandleReassignNewsWithNewsAuthors(ctx *gin.Context) {
+	var newsAuthorAssoc presentation.CreateNewsAuthorsAssoc
+
+	err := ctx.BindJSON(&newsAuthorAssoc)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Binding JSON",
+			Type:    0,
+			Data:    newsAuthorAssoc,
+		})
+		return
+	}
+
+	err = handler.usecases.ReassignNewsAuthors(ctx.Request.Context(), newsAuthorAssoc)
+	if err != nil {
+		if respondForbidden(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Data",
+			FunctionName: "HandleReassignNewsWithNewsAuthors",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Reassign News With News Authors",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+	ctx.JSON(http.StatusNoContent, "")
+}
@@ -1,18 +1,74 @@
 package rest
 
 import (
+	"context"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
 )
 
 type MockNewsDataUC struct {
-	createSingleNews        createSingleNews
-	updateSingleNews        updateSingleNews
-	deleteSingleNews        deleteSingleNews
-	getSingleNews           getSingleNews
-	getNews                 getNews
-	assignNewsWithNewsTopic assignNewsWithNewsTopic
-	assignNewsWithNewsTag   assignNewsWithNewsTag
+	createSingleNews         createSingleNews
+	updateSingleNews         updateSingleNews
+	deleteSingleNews         deleteSingleNews
+	restoreSingleNews        restoreSingleNews
+	getSingleNews            getSingleNews
+	getNews                  getNews
+	searchNews               searchNews
+	assignNewsWithNewsTopic  assignNewsWithNewsTopic
+	assignNewsWithNewsTag    assignNewsWithNewsTag
+	reassignNewsTopics       reassignNewsTopics
+	reassignNewsTags         reassignNewsTags
+	assignNewsWithNewsAuthor assignNewsWithNewsAuthor
+	reassignNewsAuthors      reassignNewsAuthors
+	transitionNews           transitionNews
+	getNewsTransitions       getNewsTransitions
+	getNewsRevisions         getNewsRevisions
+	getNewsRevision          getNewsRevision
+	diffNewsRevisions        diffNewsRevisions
+	restoreNewsRevision      restoreNewsRevision
+}
+
+type getNewsRevisions struct {
+	res []presentation.NewsRevision
+	err error
+}
+
+type getNewsRevision struct {
+	res presentation.NewsRevision
+	err error
+}
+
+type diffNewsRevisions struct {
+	res presentation.NewsRevisionDiff
+	err error
+}
+
+type restoreNewsRevision struct {
+	err error
+}
+
+type transitionNews struct {
+	err error
+}
+
+type getNewsTransitions struct {
+	res []presentation.NewsStatusTransition
+	err error
+}
+
+type reassignNewsAuthors struct {
+	err error
+}
+
+type assignNewsWithNewsAuthor struct {
+	err error
+}
+
+type reassignNewsTopics struct {
+	err error
+}
+
+type reassignNewsTags struct {
+	err error
 }
 
 type assignNewsWithNewsTopic struct {
@@ -35,34 +91,79 @@ type deleteSingleNews struct {
 	err error
 }
 
+type restoreSingleNews struct {
+	err error
+}
+
 type getSingleNews struct {
 	res presentation.GetNewsResponse
 	err error
 }
 
 type getNews struct {
-	res []presentation.GetNewsResponse
+	res presentation.GetNewsListResponse
+	err error
+}
+
+type searchNews struct {
+	res presentation.SearchNewsListResponse
 	err error
 }
 
-func (mnduc *MockNewsDataUC) CreateSingleNews(newNews presentation.CreateNewsRequest) error {
+func (mnduc *MockNewsDataUC) CreateSingleNews(ctx context.Context, newNews presentation.CreateNewsRequest) error {
 	return mnduc.createSingleNews.err
 }
-func (mnduc *MockNewsDataUC) UpdateSingleNews(updatedNews presentation.UpdateNewsRequest) error {
+func (mnduc *MockNewsDataUC) UpdateSingleNews(ctx context.Context, updatedNews presentation.UpdateNewsRequest) error {
 	return mnduc.updateSingleNews.err
 }
-func (mnduc *MockNewsDataUC) DeleteSingleNews(newsId int) error {
+func (mnduc *MockNewsDataUC) DeleteSingleNews(ctx context.Context, newsId int) error {
 	return mnduc.deleteSingleNews.err
 }
-func (mnduc *MockNewsDataUC) GetSingleNews(ctx *gin.Context, newsId int) (presentation.GetNewsResponse, error) {
+func (mnduc *MockNewsDataUC) RestoreSingleNews(ctx context.Context, newsId int) error {
+	return mnduc.restoreSingleNews.err
+}
+func (mnduc *MockNewsDataUC) GetSingleNews(ctx context.Context, newsId int) (presentation.GetNewsResponse, error) {
 	return mnduc.getSingleNews.res, mnduc.getSingleNews.err
 }
-func (mnduc *MockNewsDataUC) GetNews(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsResponse, err error) {
+func (mnduc *MockNewsDataUC) GetNews(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsListResponse, err error) {
 	return mnduc.getNews.res, mnduc.getNews.err
 }
-func (mnduc *MockNewsDataUC) AssignNewsWithNewsTopic(in presentation.CreateNewsTopicsAssoc) error {
+func (mnduc *MockNewsDataUC) SearchNews(ctx context.Context, searchQuery, paginationString, filterString string) (res presentation.SearchNewsListResponse, err error) {
+	return mnduc.searchNews.res, mnduc.searchNews.err
+}
+func (mnduc *MockNewsDataUC) AssignNewsWithNewsTopic(ctx context.Context, in presentation.CreateNewsTopicsAssoc) error {
 	return mnduc.assignNewsWithNewsTopic.err
 }
-func (mnduc *MockNewsDataUC) AssignNewsWithNewsTag(in presentation.CreateNewsTagsAssoc) error {
+func (mnduc *MockNewsDataUC) AssignNewsWithNewsTag(ctx context.Context, in presentation.CreateNewsTagsAssoc) error {
 	return mnduc.assignNewsWithNewsTag.err
 }
+func (mnduc *MockNewsDataUC) ReassignNewsTopics(ctx context.Context, in presentation.CreateNewsTopicsAssoc) error {
+	return mnduc.reassignNewsTopics.err
+}
+func (mnduc *MockNewsDataUC) ReassignNewsTags(ctx context.Context, in presentation.CreateNewsTagsAssoc) error {
+	return mnduc.reassignNewsTags.err
+}
+func (mnduc *MockNewsDataUC) AssignNewsWithNewsAuthor(ctx context.Context, in presentation.CreateNewsAuthorsAssoc) error {
+	return mnduc.assignNewsWithNewsAuthor.err
+}
+func (mnduc *MockNewsDataUC) ReassignNewsAuthors(ctx context.Context, in presentation.CreateNewsAuthorsAssoc) error {
+	return mnduc.reassignNewsAuthors.err
+}
+func (mnduc *MockNewsDataUC) TransitionNews(ctx context.Context, newsID int, transition string) error {
+	return mnduc.transitionNews.err
+}
+func (mnduc *MockNewsDataUC) GetNewsTransitions(ctx context.Context, newsID int) ([]presentation.NewsStatusTransition, error) {
+	return mnduc.getNewsTransitions.res, mnduc.getNewsTransitions.err
+}
+func (mnduc *MockNewsDataUC) GetNewsRevisions(ctx context.Context, newsID int) ([]presentation.NewsRevision, error) {
+	return mnduc.getNewsRevisions.res, mnduc.getNewsRevisions.err
+}
+func (mnduc *MockNewsDataUC) GetNewsRevision(ctx context.Context, newsID, revision int) (presentation.NewsRevision, error) {
+	return mnduc.getNewsRevision.res, mnduc.getNewsRevision.err
+}
+func (mnduc *MockNewsDataUC) DiffNewsRevisions(ctx context.Context, newsID, from, to int) (presentation.NewsRevisionDiff, error) {
+	return mnduc.diffNewsRevisions.res, mnduc.diffNewsRevisions.err
+}
+func (mnduc *MockNewsDataUC) RestoreNewsRevision(ctx context.Context, newsID, revision int) error {
+	return mnduc.restoreNewsRevision.err
+}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
 	"github.com/Mufidzz/bareksa-test/presentation"
@@ -36,6 +39,8 @@ func Test_HandleGetNews(t *testing.T) {
 		t.Fatal("Failed Generate Filter Encoded String")
 	}
 
+	listMeta := presentation.PaginationMeta{Total: 10, Offset: 0, Count: 1, HasMore: true}
+
 	testcases := []struct {
 		name           string
 		url            string
@@ -53,7 +58,7 @@ func Test_HandleGetNews(t *testing.T) {
 			},
 			mustReturnCode: http.StatusBadRequest,
 			url:            "/news",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Invalid Pagination",
@@ -67,7 +72,7 @@ func Test_HandleGetNews(t *testing.T) {
 			url:            fmt.Sprintf("/news?pagination=%sawdad", defaultPagination),
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				getNews: getNews{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -81,7 +86,49 @@ func Test_HandleGetNews(t *testing.T) {
 			url:            fmt.Sprintf("/news?pagination=%s", defaultPagination),
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				getNews: getNews{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Sort Not Allowed",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Invalid Sort, Please check sort fields are allowed and not repeated",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            fmt.Sprintf("/news?pagination=%s&sort=password", defaultPagination),
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNews: getNews{err: fmt.Errorf("wrapped %w", dbutils.ErrInvalidSort)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Tampered Cursor",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Invalid Cursor, Please use next_cursor or prev_cursor of previous page as is, without sort",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news?limit=1&cursor=tampered",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNews: getNews{err: fmt.Errorf("wrapped %w", usecase.ErrInvalidCursor)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Invalid Filter",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Invalid Filter, Please check status, match modes and date ranges of filter",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news?limit=1&status=99",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNews: getNews{err: fmt.Errorf("wrapped %w", usecase.ErrInvalidFilter)},
+			}, nil, nil, nil),
 		},
 		{
 			name: "Success #1",
@@ -100,26 +147,30 @@ func Test_HandleGetNews(t *testing.T) {
 						Status:     1,
 					},
 				},
+				Meta: listMeta,
 			},
 			mustReturnCode: http.StatusOK,
 			url:            fmt.Sprintf("/news?pagination=%s", defaultPagination),
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				getNews: getNews{
-					res: []presentation.GetNewsResponse{
-						{
-							ID:         1,
-							CreatedAt:  now,
-							UpdatedAt:  now,
-							Title:      "A",
-							Content:    "B",
-							TopicsName: "C",
-							TagsName:   "D",
-							Status:     1,
+					res: presentation.GetNewsListResponse{
+						Data: []presentation.GetNewsResponse{
+							{
+								ID:         1,
+								CreatedAt:  now,
+								UpdatedAt:  now,
+								Title:      "A",
+								Content:    "B",
+								TopicsName: "C",
+								TagsName:   "D",
+								Status:     1,
+							},
 						},
+						Meta: listMeta,
 					},
 					err: nil,
 				},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 		{
 			name: "Success #2 - With Filter",
@@ -138,26 +189,30 @@ func Test_HandleGetNews(t *testing.T) {
 						Status:     1,
 					},
 				},
+				Meta: listMeta,
 			},
 			mustReturnCode: http.StatusOK,
 			url:            fmt.Sprintf("/news?pagination=%s&filter=%s", defaultPagination, defaultFilter),
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				getNews: getNews{
-					res: []presentation.GetNewsResponse{
-						{
-							ID:         1,
-							CreatedAt:  now,
-							UpdatedAt:  now,
-							Title:      "A",
-							Content:    "B",
-							TopicsName: "C",
-							TagsName:   "D",
-							Status:     1,
+					res: presentation.GetNewsListResponse{
+						Data: []presentation.GetNewsResponse{
+							{
+								ID:         1,
+								CreatedAt:  now,
+								UpdatedAt:  now,
+								Title:      "A",
+								Content:    "B",
+								TopicsName: "C",
+								TagsName:   "D",
+								Status:     1,
+							},
 						},
+						Meta: listMeta,
 					},
 					err: nil,
 				},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -191,6 +246,126 @@ func Test_HandleGetNews(t *testing.T) {
 	}
 }
 
+func Test_HandleSearchNews(t *testing.T) {
+	now := time.Now()
+
+	defaultPagination, err := urlutils.EncodeStruct(presentation.Pagination{
+		Offset: 0,
+		Count:  1,
+	})
+	if err != nil {
+		t.Fatal("Failed Generate Pagination Encoded String")
+	}
+
+	listMeta := presentation.PaginationMeta{Total: 3, Offset: 0, Count: 1, HasMore: true}
+	searchResult := []presentation.SearchNewsResponse{
+		{
+			GetNewsResponse: presentation.GetNewsResponse{
+				ID:        1,
+				CreatedAt: now,
+				UpdatedAt: now,
+				Title:     "Market Update",
+				Content:   "B",
+				Status:    1,
+			},
+			Rank:             0.6,
+			TitleHighlight:   "<mark>Market</mark> Update",
+			ContentHighlight: "B",
+		},
+	}
+
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - No Search Query",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Search Query Cannot be Blank, use q URL Query to assign",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            fmt.Sprintf("/news/search?q=%%20&pagination=%s", defaultPagination),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - No Pagination",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Pagination Cannot be Blank, use URL Query to assign",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/search?q=market",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Search News",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            fmt.Sprintf("/news/search?q=market&pagination=%s", defaultPagination),
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				searchNews: searchNews{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Success",
+			mustReturn: response.SuccessResponse{
+				Success: true,
+				Message: "Success Searching News",
+				Data:    searchResult,
+				Meta:    listMeta,
+			},
+			mustReturnCode: http.StatusOK,
+			url:            fmt.Sprintf("/news/search?q=market&pagination=%s", defaultPagination),
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				searchNews: searchNews{
+					res: presentation.SearchNewsListResponse{
+						Data: searchResult,
+						Meta: listMeta,
+					},
+				},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tc.url, nil)
+
+			router := gin.Default()
+			router.GET("/news/search", tc.handler.HandleSearchNews)
+			router.ServeHTTP(w, req)
+
+			jsonMustResponse, err := json.Marshal(tc.mustReturn)
+			if err != nil {
+				tt.Fatal("Failed Creating JSON String")
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleSearchNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
+
 func Test_HandleGetSingleNews(t *testing.T) {
 	now := time.Now()
 
@@ -211,7 +386,7 @@ func Test_HandleGetSingleNews(t *testing.T) {
 			},
 			mustReturnCode: http.StatusBadRequest,
 			url:            "/news/alkdjhaqwd",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -225,7 +400,21 @@ func Test_HandleGetSingleNews(t *testing.T) {
 			url:            "/news/1",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				getSingleNews: getSingleNews{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Not Found",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "News Not Found",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusNotFound,
+			url:            "/news/1",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getSingleNews: getSingleNews{err: fmt.Errorf("wrapped, %w", usecase.ErrNewsNotFound)},
+			}, nil, nil, nil),
 		},
 		{
 			name: "Success",
@@ -259,7 +448,7 @@ func Test_HandleGetSingleNews(t *testing.T) {
 					},
 					err: nil,
 				},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -269,7 +458,7 @@ func Test_HandleGetSingleNews(t *testing.T) {
 			req, _ := http.NewRequest("GET", tc.url, nil)
 
 			router := gin.Default()
-			router.GET("/news/:id", tc.handler.HandleGetSingleNews)
+			router.GET("/news/:newsId", tc.handler.HandleGetSingleNews)
 			router.ServeHTTP(w, req)
 
 			var jsonMustResponse []byte
@@ -313,7 +502,7 @@ func Test_HandleCreateSingleNews(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -328,7 +517,7 @@ func Test_HandleCreateSingleNews(t *testing.T) {
 			url:            "/news",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				createSingleNews: createSingleNews{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 		{
 			name:           "Success",
@@ -338,7 +527,7 @@ func Test_HandleCreateSingleNews(t *testing.T) {
 			url:            "/news",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				createSingleNews: createSingleNews{err: nil},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -392,7 +581,7 @@ func Test_HandleUpdateSingleNews(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news/alkdjhaqwd",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Invalid JSON",
@@ -405,7 +594,7 @@ func Test_HandleUpdateSingleNews(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news/1",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -420,7 +609,52 @@ func Test_HandleUpdateSingleNews(t *testing.T) {
 			url:            "/news/1",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				updateSingleNews: updateSingleNews{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Forbidden",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Forbidden, only the writer who created the news or an editor can change it",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusForbidden,
+			body:           "{\"title\":\"AAA\",\"content\":\"XCZX\",\"status\":1}",
+			url:            "/news/1",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				updateSingleNews: updateSingleNews{err: fmt.Errorf("wrapped, %w", auth.ErrForbidden)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Illegal Transition",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Status Can not be Changed by Update, use workflow endpoints",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusConflict,
+			body:           "{\"title\":\"AAA\",\"content\":\"XCZX\",\"status\":99}",
+			url:            "/news/1",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				updateSingleNews: updateSingleNews{err: fmt.Errorf("wrapped, %w", usecase.ErrIllegalTransition)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Not Found",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "News Not Found",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusNotFound,
+			body:           "{\"title\":\"AAA\",\"content\":\"XCZX\",\"status\":1}",
+			url:            "/news/404",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				updateSingleNews: updateSingleNews{err: fmt.Errorf("wrapped, %w", usecase.ErrNewsNotFound)},
+			}, nil, nil, nil),
 		},
 		{
 			name:           "Success",
@@ -430,7 +664,7 @@ func Test_HandleUpdateSingleNews(t *testing.T) {
 			url:            "/news/1",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				updateSingleNews: updateSingleNews{err: nil},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -440,7 +674,7 @@ func Test_HandleUpdateSingleNews(t *testing.T) {
 			req, _ := http.NewRequest("PUT", tc.url, bytes.NewBuffer([]byte(tc.body)))
 
 			router := gin.Default()
-			router.PUT("/news/:id", tc.handler.HandleUpdateSingleNews)
+			router.PUT("/news/:newsId", tc.handler.HandleUpdateSingleNews)
 			router.ServeHTTP(w, req)
 
 			var jsonMustResponse []byte
@@ -482,7 +716,7 @@ func Test_HandleDeleteSingleNews(t *testing.T) {
 			},
 			mustReturnCode: http.StatusBadRequest,
 			url:            "/news/alkdjhaqwd",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -496,7 +730,7 @@ func Test_HandleDeleteSingleNews(t *testing.T) {
 			url:            "/news/1",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				deleteSingleNews: deleteSingleNews{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 		{
 			name:           "Success",
@@ -505,7 +739,7 @@ func Test_HandleDeleteSingleNews(t *testing.T) {
 			url:            "/news/1",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				createSingleNews: createSingleNews{err: nil},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -515,7 +749,7 @@ func Test_HandleDeleteSingleNews(t *testing.T) {
 			req, _ := http.NewRequest("DELETE", tc.url, nil)
 
 			router := gin.Default()
-			router.DELETE("/news/:id", tc.handler.HandleDeleteSingleNews)
+			router.DELETE("/news/:newsId", tc.handler.HandleDeleteSingleNews)
 			router.ServeHTTP(w, req)
 
 			var jsonMustResponse []byte
@@ -539,6 +773,81 @@ func Test_HandleDeleteSingleNews(t *testing.T) {
 	}
 }
 
+func Test_HandleRestoreSingleNews(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid ID Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing News ID, Please check news id is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/alkdjhaqwd/restore",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Restore Single News",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/1/restore",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				restoreSingleNews: restoreSingleNews{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			url:            "/news/1/restore",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				restoreSingleNews: restoreSingleNews{err: nil},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tc.url, nil)
+
+			router := gin.Default()
+			router.POST("/news/:newsId/restore", tc.handler.HandleRestoreSingleNews)
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleRestoreSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
+
 func Test_HandleAssignNewsWithNewsTopics(t *testing.T) {
 	testcases := []struct {
 		name           string
@@ -559,7 +868,7 @@ func Test_HandleAssignNewsWithNewsTopics(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/assign/news/news-topic",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -574,7 +883,7 @@ func Test_HandleAssignNewsWithNewsTopics(t *testing.T) {
 			url:            "/assign/news/news-topic",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				assignNewsWithNewsTopic: assignNewsWithNewsTopic{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 		{
 			name:           "Success",
@@ -584,7 +893,7 @@ func Test_HandleAssignNewsWithNewsTopics(t *testing.T) {
 			url:            "/assign/news/news-topic",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				createSingleNews: createSingleNews{err: nil},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -638,7 +947,7 @@ func Test_HandleAssignNewsWithNewsTags(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/assign/news/news-tag",
-			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil),
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -653,7 +962,7 @@ func Test_HandleAssignNewsWithNewsTags(t *testing.T) {
 			url:            "/assign/news/news-tag",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				assignNewsWithNewsTag: assignNewsWithNewsTag{err: fmt.Errorf("Adwde")},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 		{
 			name:           "Success",
@@ -663,7 +972,7 @@ func Test_HandleAssignNewsWithNewsTags(t *testing.T) {
 			url:            "/assign/news/news-tag",
 			handler: NewHTTP(nil, &MockNewsDataUC{
 				createSingleNews: createSingleNews{err: nil},
-			}, nil, nil),
+			}, nil, nil, nil),
 		},
 	}
 
@@ -695,4 +1004,314 @@ func Test_HandleAssignNewsWithNewsTags(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func Test_HandleReassignNewsWithNewsTopics(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		body           string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid JSON",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Binding JSON",
+				Type:    0,
+				Data:    presentation.CreateNewsTopicsAssoc{},
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           "",
+			url:            "/assign/news/news-topic",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Reassign News With News Topic",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           `{"news_id" : 1, "news_topic_id" : [1,2,3]}`,
+			url:            "/assign/news/news-topic",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				reassignNewsTopics: reassignNewsTopics{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			body:           `{"news_id" : 1, "news_topic_id" : [1,2,3]}`,
+			url:            "/assign/news/news-topic",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", tc.url, bytes.NewBuffer([]byte(tc.body)))
+
+			router := gin.Default()
+			router.PUT("/assign/news/news-topic", tc.handler.HandleReassignNewsWithNewsTopics)
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleReassignNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v", w.Body.String(), string(jsonMustResponse)),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleReassignNewsWithNewsTags(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		body           string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid JSON",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Binding JSON",
+				Type:    0,
+				Data:    presentation.CreateNewsTagsAssoc{},
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           "",
+			url:            "/assign/news/news-tag",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Reassign News With News Tags",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           `{"news_id" : 1, "news_tag_id" : [1,2,3]}`,
+			url:            "/assign/news/news-tag",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				reassignNewsTags: reassignNewsTags{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			body:           `{"news_id" : 1, "news_tag_id" : [1,2,3]}`,
+			url:            "/assign/news/news-tag",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", tc.url, bytes.NewBuffer([]byte(tc.body)))
+
+			router := gin.Default()
+			router.PUT("/assign/news/news-tag", tc.handler.HandleReassignNewsWithNewsTags)
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleReassignNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v", w.Body.String(), string(jsonMustResponse)),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleAssignNewsWithNewsAuthors(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		body           string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid JSON",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Binding JSON",
+				Type:    0,
+				Data:    presentation.CreateNewsAuthorsAssoc{},
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           "",
+			url:            "/assign/news/news-author",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Assign News With News Authors",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           `{"news_id" : 1, "news_author_id" : [1,2,3]}`,
+			url:            "/assign/news/news-author",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				assignNewsWithNewsAuthor: assignNewsWithNewsAuthor{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			body:           `{"news_id" : 1, "news_author_id" : [1,2,3]}`,
+			url:            "/assign/news/news-author",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				createSingleNews: createSingleNews{err: nil},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tc.url, bytes.NewBuffer([]byte(tc.body)))
+
+			router := gin.Default()
+			router.POST("/assign/news/news-author", tc.handler.HandleAssignNewsWithNewsAuthors)
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleAssignNewsWithNewsAuthors",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v", w.Body.String(), string(jsonMustResponse)),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleReassignNewsWithNewsAuthors(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		body           string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid JSON",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Binding JSON",
+				Type:    0,
+				Data:    presentation.CreateNewsAuthorsAssoc{},
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           "",
+			url:            "/assign/news/news-author",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Reassign News With News Authors",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			body:           `{"news_id" : 1, "news_author_id" : [1,2,3]}`,
+			url:            "/assign/news/news-author",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				reassignNewsAuthors: reassignNewsAuthors{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			body:           `{"news_id" : 1, "news_author_id" : [1,2,3]}`,
+			url:            "/assign/news/news-author",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", tc.url, bytes.NewBuffer([]byte(tc.body)))
+
+			router := gin.Default()
+			router.PUT("/assign/news/news-author", tc.handler.HandleReassignNewsWithNewsAuthors)
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleReassignNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v", w.Body.String(), string(jsonMustResponse)),
+				}.Error())
+			}
+		})
+	}
+}
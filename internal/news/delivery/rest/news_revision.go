@@ -0,0 +1,214 @@
+package rest
+
+import (
+	"errors"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+func (handler *HTTPHandler) HandleGetNewsRevisions(ctx *gin.Context) {
+	intNewsID, err := strconv.Atoi(ctx.Param("newsId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing News ID, Please check news id is valid Number",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	revisions, err := handler.usecases.GetNewsRevisions(ctx.Request.Context(), intNewsID)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Revision",
+			FunctionName: "HandleGetNewsRevisions",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Get News Revisions",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Getting News Revisions",
+		Data:    revisions,
+	})
+}
+
+func (handler *HTTPHandler) HandleGetNewsRevision(ctx *gin.Context) {
+	intNewsID, intRevision, ok := parseRevisionParams(ctx)
+	if !ok {
+		return
+	}
+
+	revision, err := handler.usecases.GetNewsRevision(ctx.Request.Context(), intNewsID, intRevision)
+	if err != nil {
+		if respondRevisionNotFound(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Revision",
+			FunctionName: "HandleGetNewsRevision",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Get News Revision",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Getting News Revision",
+		Data:    revision,
+	})
+}
+
+// HandleDiffNewsRevisions answer line based diff of title and content from revision to otherRevision
+func (handler *HTTPHandler) HandleDiffNewsRevisions(ctx *gin.Context) {
+	intNewsID, intRevision, ok := parseRevisionParams(ctx)
+	if !ok {
+		return
+	}
+
+	intOtherRevision, err := strconv.Atoi(ctx.Param("otherRevision"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Revision, Please check revision is valid Number",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	diff, err := handler.usecases.DiffNewsRevisions(ctx.Request.Context(), intNewsID, intRevision, intOtherRevision)
+	if err != nil {
+		if respondRevisionNotFound(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Revision",
+			FunctionName: "HandleDiffNewsRevisions",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Diff News Revisions",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Getting News Revisions Diff",
+		Data:    diff,
+	})
+}
+
+// HandleRestoreNewsRevision write content of old revision back to news as new revision
+func (handler *HTTPHandler) HandleRestoreNewsRevision(ctx *gin.Context) {
+	intNewsID, intRevision, ok := parseRevisionParams(ctx)
+	if !ok {
+		return
+	}
+
+	err := handler.usecases.RestoreNewsRevision(ctx.Request.Context(), intNewsID, intRevision)
+	if err != nil {
+		if respondForbidden(ctx, err) || respondRevisionNotFound(ctx, err) {
+			return
+		}
+
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Revision",
+			FunctionName: "HandleRestoreNewsRevision",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Restore News Revision",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, "")
+}
+
+// parseRevisionParams read newsId and revision URL parameters, answering 400 and returning false when either is not a number
+func parseRevisionParams(ctx *gin.Context) (newsID, revision int, ok bool) {
+	newsID, err := strconv.Atoi(ctx.Param("newsId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing News ID, Please check news id is valid Number",
+			Type:    0,
+			Data:    nil,
+		})
+		return 0, 0, false
+	}
+
+	revision, err = strconv.Atoi(ctx.Param("revision"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Revision, Please check revision is valid Number",
+			Type:    0,
+			Data:    nil,
+		})
+		return 0, 0, false
+	}
+
+	return newsID, revision, true
+}
+
+// respondRevisionNotFound answer 404 when revision or its news does not exist.
+// It return false and write nothing for every other error
+func respondRevisionNotFound(ctx *gin.Context, err error) bool {
+	if !errors.Is(err, usecase.ErrRevisionNotFound) && !errors.Is(err, usecase.ErrNewsNotFound) {
+		return false
+	}
+
+	message := "News Revision Not Found"
+	if errors.Is(err, usecase.ErrNewsNotFound) {
+		message = "News Not Found"
+	}
+
+	ctx.JSON(http.StatusNotFound, response.ErrorResponse{
+		Success: false,
+		Message: message,
+		Type:    0,
+		Data:    nil,
+	})
+	return true
+}
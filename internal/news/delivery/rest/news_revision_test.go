@@ -0,0 +1,384 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/textdiff"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func Test_HandleGetNewsRevisions(t *testing.T) {
+	revisions := []presentation.NewsRevision{
+		{ID: 1, NewsID: 1, Revision: 1, Title: "A", Content: "B"},
+	}
+
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid ID Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing News ID, Please check news id is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/alkdjhaqwd/revisions",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Get News Revisions",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            "/news/1/revisions",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsRevisions: getNewsRevisions{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Success",
+			mustReturn: response.SuccessResponse{
+				Success: true,
+				Message: "Success Getting News Revisions",
+				Data:    revisions,
+			},
+			mustReturnCode: http.StatusOK,
+			url:            "/news/1/revisions",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsRevisions: getNewsRevisions{res: revisions},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tc.url, nil)
+
+			router := gin.Default()
+			router.GET("/news/:newsId/revisions", tc.handler.HandleGetNewsRevisions)
+			router.ServeHTTP(w, req)
+
+			jsonMustResponse, err := json.Marshal(tc.mustReturn)
+			if err != nil {
+				tt.Fatal("Failed Creating JSON String")
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleGetNewsRevisions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleGetNewsRevision(t *testing.T) {
+	revision := presentation.NewsRevision{ID: 1, NewsID: 1, Revision: 1, Title: "A", Content: "B"}
+
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid Revision Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing Revision, Please check revision is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/1/revisions/alkdjhaqwd",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Revision Not Found",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "News Revision Not Found",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusNotFound,
+			url:            "/news/1/revisions/1",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsRevision: getNewsRevision{err: fmt.Errorf("wrapped: %w", usecase.ErrRevisionNotFound)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Get News Revision",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            "/news/1/revisions/1",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsRevision: getNewsRevision{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Success",
+			mustReturn: response.SuccessResponse{
+				Success: true,
+				Message: "Success Getting News Revision",
+				Data:    revision,
+			},
+			mustReturnCode: http.StatusOK,
+			url:            "/news/1/revisions/1",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsRevision: getNewsRevision{res: revision},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tc.url, nil)
+
+			router := gin.Default()
+			router.GET("/news/:newsId/revisions/:revision", tc.handler.HandleGetNewsRevision)
+			router.ServeHTTP(w, req)
+
+			jsonMustResponse, err := json.Marshal(tc.mustReturn)
+			if err != nil {
+				tt.Fatal("Failed Creating JSON String")
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleGetNewsRevision",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleDiffNewsRevisions(t *testing.T) {
+	diff := presentation.NewsRevisionDiff{
+		NewsID:  1,
+		From:    1,
+		To:      2,
+		Title:   []presentation.DiffLine{{Op: textdiff.OP_EQUAL, Text: "A"}},
+		Content: []presentation.DiffLine{{Op: textdiff.OP_DELETE, Text: "B"}, {Op: textdiff.OP_INSERT, Text: "C"}},
+	}
+
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid Other Revision Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing Revision, Please check revision is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/1/revisions/1/diff/alkdjhaqwd",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Revision Not Found",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "News Revision Not Found",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusNotFound,
+			url:            "/news/1/revisions/1/diff/2",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				diffNewsRevisions: diffNewsRevisions{err: fmt.Errorf("wrapped: %w", usecase.ErrRevisionNotFound)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Diff News Revisions",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            "/news/1/revisions/1/diff/2",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				diffNewsRevisions: diffNewsRevisions{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Success",
+			mustReturn: response.SuccessResponse{
+				Success: true,
+				Message: "Success Getting News Revisions Diff",
+				Data:    diff,
+			},
+			mustReturnCode: http.StatusOK,
+			url:            "/news/1/revisions/1/diff/2",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				diffNewsRevisions: diffNewsRevisions{res: diff},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tc.url, nil)
+
+			router := gin.Default()
+			router.GET("/news/:newsId/revisions/:revision/diff/:otherRevision", tc.handler.HandleDiffNewsRevisions)
+			router.ServeHTTP(w, req)
+
+			jsonMustResponse, err := json.Marshal(tc.mustReturn)
+			if err != nil {
+				tt.Fatal("Failed Creating JSON String")
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleDiffNewsRevisions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleRestoreNewsRevision(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid ID Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing News ID, Please check news id is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/alkdjhaqwd/revisions/1/restore",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Forbidden",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Forbidden, only the writer who created the news or an editor can change it",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusForbidden,
+			url:            "/news/1/revisions/1/restore",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				restoreNewsRevision: restoreNewsRevision{err: fmt.Errorf("wrapped: %w", auth.ErrForbidden)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - News Not Found",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "News Not Found",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusNotFound,
+			url:            "/news/1/revisions/1/restore",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				restoreNewsRevision: restoreNewsRevision{err: fmt.Errorf("wrapped: %w", usecase.ErrNewsNotFound)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Restore News Revision",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            "/news/1/revisions/1/restore",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				restoreNewsRevision: restoreNewsRevision{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			url:            "/news/1/revisions/1/restore",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tc.url, nil)
+
+			router := gin.Default()
+			router.POST("/news/:newsId/revisions/:revision/restore", tc.handler.HandleRestoreNewsRevision)
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleRestoreNewsRevision",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
@@ -10,10 +10,25 @@ import (
 )
 
 func (handler *HTTPHandler) HandleGetNewsTag(ctx *gin.Context) {
-	filterString, paginationString := ctx.Query("filter"), ctx.Query("pagination")
+	paginationString, filterString, err := bindListQuery(ctx, &presentation.Pagination{}, &presentation.NewsTagsFilter{})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Query, Please check filter and pagination query are valid",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	sortString := ctx.Query("sort")
 
-	news, err := handler.usecases.GetNewsTags(ctx, paginationString, filterString)
+	news, err := handler.usecases.GetNewsTags(ctx.Request.Context(), paginationString, filterString, sortString)
 	if err != nil {
+		if respondInvalidQuery(ctx, err) {
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -34,7 +49,8 @@ func (handler *HTTPHandler) HandleGetNewsTag(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
 		Message: "Success Getting News",
-		Data:    news,
+		Data:    news.Data,
+		Meta:    news.Meta,
 	})
 }
 
@@ -52,7 +68,7 @@ func (handler *HTTPHandler) HandleUpdateNewsTag(ctx *gin.Context) {
 		return
 	}
 
-	updatedIDs, err := handler.usecases.UpdateNewsTags(newNewsTag)
+	updatedIDs, err := handler.usecases.UpdateNewsTags(ctx.Request.Context(), newNewsTag)
 	if err != nil {
 		logger.Error(response.InternalError{
 			Type:         "Handler",
@@ -94,7 +110,7 @@ func (handler *HTTPHandler) HandleCreateNewsTag(ctx *gin.Context) {
 		return
 	}
 
-	_, err = handler.usecases.CreateNewsTags(newNewsTag)
+	_, err = handler.usecases.CreateNewsTags(ctx.Request.Context(), newNewsTag)
 	if err != nil {
 		logger.Error(response.InternalError{
 			Type:         "Handler",
@@ -145,7 +161,7 @@ func (handler *HTTPHandler) HandleDeleteNewsTag(ctx *gin.Context) {
 		intTagIds = append(intTagIds, _t)
 	}
 
-	deletedIds, err := handler.usecases.DeleteNewsTags(intTagIds)
+	deletedIds, err := handler.usecases.DeleteNewsTags(ctx.Request.Context(), intTagIds)
 
 	if err != nil {
 		logger.Error(response.InternalError{
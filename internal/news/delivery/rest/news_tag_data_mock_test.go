@@ -1,8 +1,8 @@
 package rest
 
 import (
+	"context"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
 )
 
 type MockNewsTagDataUC struct {
@@ -28,19 +28,19 @@ type updateNewsTags struct {
 }
 
 type getNewsTags struct {
-	res []presentation.GetNewsTagsResponse
+	res presentation.GetNewsTagsListResponse
 	err error
 }
 
-func (mntduc *MockNewsTagDataUC) CreateNewsTags(in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
+func (mntduc *MockNewsTagDataUC) CreateNewsTags(ctx context.Context, in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
 	return mntduc.createNewsTags.insertedID, mntduc.createNewsTags.err
 }
-func (mntduc *MockNewsTagDataUC) DeleteNewsTags(newsTopicID []int) (deletedID []int, err error) {
+func (mntduc *MockNewsTagDataUC) DeleteNewsTags(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
 	return mntduc.deleteNewsTags.deletedID, mntduc.deleteNewsTags.err
 }
-func (mntduc *MockNewsTagDataUC) UpdateNewsTags(newNewsTags []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
+func (mntduc *MockNewsTagDataUC) UpdateNewsTags(ctx context.Context, newNewsTags []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
 	return mntduc.updateNewsTags.updatedID, mntduc.updateNewsTags.err
 }
-func (mntduc *MockNewsTagDataUC) GetNewsTags(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsTagsResponse, err error) {
+func (mntduc *MockNewsTagDataUC) GetNewsTags(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsTagsListResponse, err error) {
 	return mntduc.getNewsTags.res, mntduc.getNewsTags.err
 }
@@ -34,7 +34,7 @@ func Test_HandleCreateNewsTag(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news-tag",
-			handler:        NewHTTP(nil, nil, nil, &MockNewsTagDataUC{}),
+			handler:        NewHTTP(nil, nil, nil, &MockNewsTagDataUC{}, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -49,7 +49,7 @@ func Test_HandleCreateNewsTag(t *testing.T) {
 			url:            "/news-tag",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				createNewsTags: createNewsTags{err: fmt.Errorf("a")},
-			}),
+			}, nil),
 		},
 		{
 			name:           "Success",
@@ -59,7 +59,7 @@ func Test_HandleCreateNewsTag(t *testing.T) {
 			url:            "/news-tag",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				createNewsTags: createNewsTags{insertedID: []int{1, 2}},
-			}),
+			}, nil),
 		},
 	}
 
@@ -111,6 +111,8 @@ func Test_HandleGetNewsTag(t *testing.T) {
 		t.Fatal("Failed Generate Filter Encoded String")
 	}
 
+	listMeta := presentation.PaginationMeta{Total: 10, Offset: 0, Count: 2, HasMore: true}
+
 	testcases := []struct {
 		name           string
 		url            string
@@ -130,7 +132,7 @@ func Test_HandleGetNewsTag(t *testing.T) {
 			url:            fmt.Sprintf("/news-tag?pagination=%sawdad", defaultPagination),
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				getNewsTags: getNewsTags{err: fmt.Errorf("invalid pagination")},
-			}),
+			}, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -144,7 +146,7 @@ func Test_HandleGetNewsTag(t *testing.T) {
 			url:            "/news-tag",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				getNewsTags: getNewsTags{err: fmt.Errorf("other error")},
-			}),
+			}, nil),
 		},
 		{
 			name: "Success #1",
@@ -161,23 +163,27 @@ func Test_HandleGetNewsTag(t *testing.T) {
 						Name: "B",
 					},
 				},
+				Meta: listMeta,
 			},
 			mustReturnCode: http.StatusOK,
 			url:            fmt.Sprintf("/news-tag?pagination=%s", defaultPagination),
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				getNewsTags: getNewsTags{
-					res: []presentation.GetNewsTagsResponse{
-						{
-							ID:   1,
-							Name: "A",
-						},
-						{
-							ID:   2,
-							Name: "B",
+					res: presentation.GetNewsTagsListResponse{
+						Data: []presentation.GetNewsTagsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
 						},
+						Meta: listMeta,
 					},
 				},
-			}),
+			}, nil),
 		},
 		{
 			name: "Success #2 - With Filter",
@@ -194,23 +200,27 @@ func Test_HandleGetNewsTag(t *testing.T) {
 						Name: "B",
 					},
 				},
+				Meta: listMeta,
 			},
 			mustReturnCode: http.StatusOK,
 			url:            fmt.Sprintf("/news-tag?pagination=%s&filter=%s", defaultPagination, defaultFilter),
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				getNewsTags: getNewsTags{
-					res: []presentation.GetNewsTagsResponse{
-						{
-							ID:   1,
-							Name: "A",
-						},
-						{
-							ID:   2,
-							Name: "B",
+					res: presentation.GetNewsTagsListResponse{
+						Data: []presentation.GetNewsTagsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
 						},
+						Meta: listMeta,
 					},
 				},
-			}),
+			}, nil),
 		},
 	}
 
@@ -264,7 +274,7 @@ func Test_HandleUpdateNewsTag(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news-tag",
-			handler:        NewHTTP(nil, nil, nil, &MockNewsTagDataUC{}),
+			handler:        NewHTTP(nil, nil, nil, &MockNewsTagDataUC{}, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -279,7 +289,7 @@ func Test_HandleUpdateNewsTag(t *testing.T) {
 			url:            "/news-tag",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				updateNewsTags: updateNewsTags{err: fmt.Errorf("a")},
-			}),
+			}, nil),
 		},
 		{
 			name: "Success",
@@ -295,7 +305,7 @@ func Test_HandleUpdateNewsTag(t *testing.T) {
 			url:            "/news-tag",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				updateNewsTags: updateNewsTags{updatedID: []int{1, 2}},
-			}),
+			}, nil),
 		},
 	}
 
@@ -347,7 +357,7 @@ func Test_HandleDeleteNewsTag(t *testing.T) {
 			},
 			mustReturnCode: http.StatusBadRequest,
 			url:            "/news-tag",
-			handler:        NewHTTP(nil, nil, nil, &MockNewsTagDataUC{}),
+			handler:        NewHTTP(nil, nil, nil, &MockNewsTagDataUC{}, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -361,7 +371,7 @@ func Test_HandleDeleteNewsTag(t *testing.T) {
 			url:            "/news-tag?id=1&id=2",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				deleteNewsTags: deleteNewsTags{err: fmt.Errorf("a")},
-			}),
+			}, nil),
 		},
 		{
 			name: "Success",
@@ -376,7 +386,7 @@ func Test_HandleDeleteNewsTag(t *testing.T) {
 			url:            "/news-tag?id=1&id=2",
 			handler: NewHTTP(nil, nil, nil, &MockNewsTagDataUC{
 				deleteNewsTags: deleteNewsTags{deletedID: []int{1, 2}},
-			}),
+			}, nil),
 		},
 	}
 
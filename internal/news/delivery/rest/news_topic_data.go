@@ -10,10 +10,25 @@ import (
 )
 
 func (handler *HTTPHandler) HandleGetNewsTopic(ctx *gin.Context) {
-	filterString, paginationString := ctx.Query("filter"), ctx.Query("pagination")
+	paginationString, filterString, err := bindListQuery(ctx, &presentation.Pagination{}, &presentation.NewsTopicFilter{})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing Query, Please check filter and pagination query are valid",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	sortString := ctx.Query("sort")
 
-	news, err := handler.usecases.GetNewsTopics(ctx, paginationString, filterString)
+	news, err := handler.usecases.GetNewsTopics(ctx.Request.Context(), paginationString, filterString, sortString)
 	if err != nil {
+		if respondInvalidQuery(ctx, err) {
+			return
+		}
+
 		logger.Error(response.InternalError{
 			Type:         "Handler",
 			Name:         "News Data",
@@ -34,7 +49,8 @@ func (handler *HTTPHandler) HandleGetNewsTopic(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response.SuccessResponse{
 		Success: true,
 		Message: "Success Getting News",
-		Data:    news,
+		Data:    news.Data,
+		Meta:    news.Meta,
 	})
 }
 
@@ -52,7 +68,7 @@ func (handler *HTTPHandler) HandleUpdateNewsTopic(ctx *gin.Context) {
 		return
 	}
 
-	updatedIDs, err := handler.usecases.UpdateNewsTopics(newNewsTopic)
+	updatedIDs, err := handler.usecases.UpdateNewsTopics(ctx.Request.Context(), newNewsTopic)
 	if err != nil {
 		logger.Error(response.InternalError{
 			Type:         "Handler",
@@ -94,7 +110,7 @@ func (handler *HTTPHandler) HandleCreateNewsTopic(ctx *gin.Context) {
 		return
 	}
 
-	_, err = handler.usecases.CreateNewsTopics(newNewsTopic)
+	_, err = handler.usecases.CreateNewsTopics(ctx.Request.Context(), newNewsTopic)
 	if err != nil {
 		logger.Error(response.InternalError{
 			Type:         "Handler",
@@ -145,7 +161,7 @@ func (handler *HTTPHandler) HandleDeleteNewsTopic(ctx *gin.Context) {
 		intTopicIds = append(intTopicIds, _t)
 	}
 
-	deletedIds, err := handler.usecases.DeleteNewsTopics(intTopicIds)
+	deletedIds, err := handler.usecases.DeleteNewsTopics(ctx.Request.Context(), intTopicIds)
 
 	if err != nil {
 		logger.Error(response.InternalError{
@@ -1,8 +1,8 @@
 package rest
 
 import (
+	"context"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
 )
 
 type MockNewsTopicDataUC struct {
@@ -28,19 +28,19 @@ type updateNewsTopics struct {
 }
 
 type getNewsTopics struct {
-	res []presentation.GetNewsTopicsResponse
+	res presentation.GetNewsTopicsListResponse
 	err error
 }
 
-func (mntduc *MockNewsTopicDataUC) CreateNewsTopics(in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
+func (mntduc *MockNewsTopicDataUC) CreateNewsTopics(ctx context.Context, in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
 	return mntduc.createNewsTopics.insertedID, mntduc.createNewsTopics.err
 }
-func (mntduc *MockNewsTopicDataUC) DeleteNewsTopics(newsTopicID []int) (deletedID []int, err error) {
+func (mntduc *MockNewsTopicDataUC) DeleteNewsTopics(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
 	return mntduc.deleteNewsTopics.deletedID, mntduc.deleteNewsTopics.err
 }
-func (mntduc *MockNewsTopicDataUC) UpdateNewsTopics(newNewsTopics []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
+func (mntduc *MockNewsTopicDataUC) UpdateNewsTopics(ctx context.Context, newNewsTopics []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
 	return mntduc.updateNewsTopics.updatedID, mntduc.updateNewsTopics.err
 }
-func (mntduc *MockNewsTopicDataUC) GetNewsTopics(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsTopicsResponse, err error) {
+func (mntduc *MockNewsTopicDataUC) GetNewsTopics(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsTopicsListResponse, err error) {
 	return mntduc.getNewsTopics.res, mntduc.getNewsTopics.err
 }
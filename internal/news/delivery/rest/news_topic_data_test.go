@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
 	"github.com/Mufidzz/bareksa-test/presentation"
@@ -34,7 +35,7 @@ func Test_HandleCreateNewsTopic(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news-topic",
-			handler:        NewHTTP(nil, nil, &MockNewsTopicDataUC{}, nil),
+			handler:        NewHTTP(nil, nil, &MockNewsTopicDataUC{}, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -49,7 +50,7 @@ func Test_HandleCreateNewsTopic(t *testing.T) {
 			url:            "/news-topic",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				createNewsTopics: createNewsTopics{err: fmt.Errorf("a")},
-			}, nil),
+			}, nil, nil),
 		},
 		{
 			name:           "Success",
@@ -59,7 +60,7 @@ func Test_HandleCreateNewsTopic(t *testing.T) {
 			url:            "/news-topic",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				createNewsTopics: createNewsTopics{insertedID: []int{1, 2}},
-			}, nil),
+			}, nil, nil),
 		},
 	}
 
@@ -111,6 +112,8 @@ func Test_HandleGetNewsTopic(t *testing.T) {
 		t.Fatal("Failed Generate Filter Encoded String")
 	}
 
+	listMeta := presentation.PaginationMeta{Total: 10, Offset: 0, Count: 2, HasMore: true}
+
 	testcases := []struct {
 		name           string
 		url            string
@@ -130,7 +133,7 @@ func Test_HandleGetNewsTopic(t *testing.T) {
 			url:            fmt.Sprintf("/news-topic?pagination=%sawdad", defaultPagination),
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				getNewsTopics: getNewsTopics{err: fmt.Errorf("invalid pagination")},
-			}, nil),
+			}, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -144,7 +147,21 @@ func Test_HandleGetNewsTopic(t *testing.T) {
 			url:            "/news-topic",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				getNewsTopics: getNewsTopics{err: fmt.Errorf("other error")},
-			}, nil),
+			}, nil, nil),
+		},
+		{
+			name: "Failed - Sort Not Allowed",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Invalid Sort, Please check sort fields are allowed and not repeated",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news-topic?sort=password",
+			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
+				getNewsTopics: getNewsTopics{err: fmt.Errorf("wrapped %w", dbutils.ErrInvalidSort)},
+			}, nil, nil),
 		},
 		{
 			name: "Success #1",
@@ -161,23 +178,27 @@ func Test_HandleGetNewsTopic(t *testing.T) {
 						Name: "B",
 					},
 				},
+				Meta: listMeta,
 			},
 			mustReturnCode: http.StatusOK,
 			url:            fmt.Sprintf("/news-topic?pagination=%s", defaultPagination),
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				getNewsTopics: getNewsTopics{
-					res: []presentation.GetNewsTopicsResponse{
-						{
-							ID:   1,
-							Name: "A",
-						},
-						{
-							ID:   2,
-							Name: "B",
+					res: presentation.GetNewsTopicsListResponse{
+						Data: []presentation.GetNewsTopicsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
 						},
+						Meta: listMeta,
 					},
 				},
-			}, nil),
+			}, nil, nil),
 		},
 		{
 			name: "Success #2 - With Filter",
@@ -194,23 +215,27 @@ func Test_HandleGetNewsTopic(t *testing.T) {
 						Name: "B",
 					},
 				},
+				Meta: listMeta,
 			},
 			mustReturnCode: http.StatusOK,
 			url:            fmt.Sprintf("/news-topic?pagination=%s&filter=%s", defaultPagination, defaultFilter),
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				getNewsTopics: getNewsTopics{
-					res: []presentation.GetNewsTopicsResponse{
-						{
-							ID:   1,
-							Name: "A",
-						},
-						{
-							ID:   2,
-							Name: "B",
+					res: presentation.GetNewsTopicsListResponse{
+						Data: []presentation.GetNewsTopicsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
 						},
+						Meta: listMeta,
 					},
 				},
-			}, nil),
+			}, nil, nil),
 		},
 	}
 
@@ -264,7 +289,7 @@ func Test_HandleUpdateNewsTopic(t *testing.T) {
 			mustReturnCode: http.StatusBadRequest,
 			body:           "",
 			url:            "/news-topic",
-			handler:        NewHTTP(nil, nil, &MockNewsTopicDataUC{}, nil),
+			handler:        NewHTTP(nil, nil, &MockNewsTopicDataUC{}, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -279,7 +304,7 @@ func Test_HandleUpdateNewsTopic(t *testing.T) {
 			url:            "/news-topic",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				updateNewsTopics: updateNewsTopics{err: fmt.Errorf("a")},
-			}, nil),
+			}, nil, nil),
 		},
 		{
 			name: "Success",
@@ -295,7 +320,7 @@ func Test_HandleUpdateNewsTopic(t *testing.T) {
 			url:            "/news-topic",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				updateNewsTopics: updateNewsTopics{updatedID: []int{1, 2}},
-			}, nil),
+			}, nil, nil),
 		},
 	}
 
@@ -347,7 +372,7 @@ func Test_HandleDeleteNewsTopic(t *testing.T) {
 			},
 			mustReturnCode: http.StatusBadRequest,
 			url:            "/news-topic",
-			handler:        NewHTTP(nil, nil, &MockNewsTopicDataUC{}, nil),
+			handler:        NewHTTP(nil, nil, &MockNewsTopicDataUC{}, nil, nil),
 		},
 		{
 			name: "Failed - Usecase Return Error",
@@ -361,7 +386,7 @@ func Test_HandleDeleteNewsTopic(t *testing.T) {
 			url:            "/news-topic?id=1&id=2",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				deleteNewsTopics: deleteNewsTopics{err: fmt.Errorf("a")},
-			}, nil),
+			}, nil, nil),
 		},
 		{
 			name: "Success",
@@ -376,7 +401,7 @@ func Test_HandleDeleteNewsTopic(t *testing.T) {
 			url:            "/news-topic?id=1&id=2",
 			handler: NewHTTP(nil, nil, &MockNewsTopicDataUC{
 				deleteNewsTopics: deleteNewsTopics{deletedID: []int{1, 2}},
-			}, nil),
+			}, nil, nil),
 		},
 	}
 
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"errors"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// HandleTransitionNews return handler moving news through workflow transition, ex. presentation.NEWS_TRANSITION_PUBLISH.
+// Transition not allowed from current news status is answered with 409
+func (handler *HTTPHandler) HandleTransitionNews(transition string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		intNewsID, err := strconv.Atoi(ctx.Param("newsId"))
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing News ID, Please check news id is valid Number",
+				Type:    0,
+				Data:    nil,
+			})
+			return
+		}
+
+		err = handler.usecases.TransitionNews(ctx.Request.Context(), intNewsID, transition)
+		if err != nil {
+			if respondForbidden(ctx, err) || respondIllegalTransition(ctx, err, "Can not "+transition+" News in its Current Status") {
+				return
+			}
+
+			if errors.Is(err, usecase.ErrNewsNotFound) {
+				ctx.JSON(http.StatusNotFound, response.ErrorResponse{
+					Success: false,
+					Message: "News Not Found",
+					Type:    0,
+					Data:    nil,
+				})
+				return
+			}
+
+			logger.Error(response.InternalError{
+				Type:         "Handler",
+				Name:         "News Workflow",
+				FunctionName: "HandleTransitionNews",
+				Description:  "error running usecase",
+				Trace:        err,
+			}.Error())
+
+			ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run " + transition + " News",
+				Type:    0,
+				Data:    nil,
+			})
+			return
+		}
+
+		ctx.JSON(http.StatusNoContent, "")
+	}
+}
+
+func (handler *HTTPHandler) HandleGetNewsTransitions(ctx *gin.Context) {
+	intNewsID, err := strconv.Atoi(ctx.Param("newsId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Parsing News ID, Please check news id is valid Number",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	transitions, err := handler.usecases.GetNewsTransitions(ctx.Request.Context(), intNewsID)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "Handler",
+			Name:         "News Workflow",
+			FunctionName: "HandleGetNewsTransitions",
+			Description:  "error running usecase",
+			Trace:        err,
+		}.Error())
+
+		ctx.JSON(http.StatusInternalServerError, response.ErrorResponse{
+			Success: false,
+			Message: "Failed Run Get News Transitions",
+			Type:    0,
+			Data:    nil,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.SuccessResponse{
+		Success: true,
+		Message: "Success Getting News Transitions",
+		Data:    transitions,
+	})
+}
+
+// respondIllegalTransition answer 409 with message when usecase refused status change.
+// It return false and write nothing for every other error
+func respondIllegalTransition(ctx *gin.Context, err error, message string) bool {
+	if !errors.Is(err, usecase.ErrIllegalTransition) {
+		return false
+	}
+
+	ctx.JSON(http.StatusConflict, response.ErrorResponse{
+		Success: false,
+		Message: message,
+		Type:    0,
+		Data:    nil,
+	})
+	return true
+}
@@ -0,0 +1,209 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func Test_HandleTransitionNews(t *testing.T) {
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid ID Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing News ID, Please check news id is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/alkdjhaqwd/publish",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Illegal Transition",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Can not publish News in its Current Status",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusConflict,
+			url:            "/news/1/publish",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				transitionNews: transitionNews{err: fmt.Errorf("wrapped: %w", usecase.ErrIllegalTransition)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - News Not Found",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "News Not Found",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusNotFound,
+			url:            "/news/1/publish",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				transitionNews: transitionNews{err: fmt.Errorf("wrapped: %w", usecase.ErrNewsNotFound)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Forbidden",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Forbidden, only the writer who created the news or an editor can change it",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusForbidden,
+			url:            "/news/1/publish",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				transitionNews: transitionNews{err: fmt.Errorf("wrapped: %w", auth.ErrForbidden)},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run publish News",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            "/news/1/publish",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				transitionNews: transitionNews{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name:           "Success",
+			mustReturn:     "",
+			mustReturnCode: http.StatusNoContent,
+			url:            "/news/1/publish",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", tc.url, nil)
+
+			router := gin.Default()
+			router.POST("/news/:newsId/publish", tc.handler.HandleTransitionNews(presentation.NEWS_TRANSITION_PUBLISH))
+			router.ServeHTTP(w, req)
+
+			var jsonMustResponse []byte
+			var err error
+			if tc.mustReturn != "" {
+				jsonMustResponse, err = json.Marshal(tc.mustReturn)
+				if err != nil {
+					tt.Fatal("Failed Creating JSON String")
+				}
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleTransitionNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_HandleGetNewsTransitions(t *testing.T) {
+	transitions := []presentation.NewsStatusTransition{
+		{ID: 1, NewsID: 1, Transition: presentation.NEWS_TRANSITION_SUBMIT, FromStatus: presentation.NEWS_STATUS_DRAFT, ToStatus: presentation.NEWS_STATUS_IN_REVIEW},
+	}
+
+	testcases := []struct {
+		name           string
+		url            string
+		mustReturn     interface{}
+		mustReturnCode int
+		handler        *HTTPHandler
+	}{
+		{
+			name: "Failed - Invalid ID Param",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Parsing News ID, Please check news id is valid Number",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusBadRequest,
+			url:            "/news/alkdjhaqwd/transitions",
+			handler:        NewHTTP(nil, &MockNewsDataUC{}, nil, nil, nil),
+		},
+		{
+			name: "Failed - Usecase Return Error",
+			mustReturn: response.ErrorResponse{
+				Success: false,
+				Message: "Failed Run Get News Transitions",
+				Type:    0,
+				Data:    nil,
+			},
+			mustReturnCode: http.StatusInternalServerError,
+			url:            "/news/1/transitions",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsTransitions: getNewsTransitions{err: fmt.Errorf("Adwde")},
+			}, nil, nil, nil),
+		},
+		{
+			name: "Success",
+			mustReturn: response.SuccessResponse{
+				Success: true,
+				Message: "Success Getting News Transitions",
+				Data:    transitions,
+			},
+			mustReturnCode: http.StatusOK,
+			url:            "/news/1/transitions",
+			handler: NewHTTP(nil, &MockNewsDataUC{
+				getNewsTransitions: getNewsTransitions{res: transitions},
+			}, nil, nil, nil),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tc.url, nil)
+
+			router := gin.Default()
+			router.GET("/news/:newsId/transitions", tc.handler.HandleGetNewsTransitions)
+			router.ServeHTTP(w, req)
+
+			jsonMustResponse, err := json.Marshal(tc.mustReturn)
+			if err != nil {
+				tt.Fatal("Failed Creating JSON String")
+			}
+
+			if tc.mustReturnCode != w.Code || !reflect.DeepEqual(jsonMustResponse, w.Body.Bytes()) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_HandleGetNewsTransitions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %s, expected %v, code %v", w.Body.String(), string(jsonMustResponse), w.Code),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"errors"
+	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"reflect"
+)
+
+// PLAIN_PAGINATION_PARAMS are plain query params of presentation.Pagination, any of them mean pagination is sent unencoded
+var PLAIN_PAGINATION_PARAMS = []string{"limit", "offset", "cursor"}
+
+// bindListQuery return encoded pagination and filter for usecase. Encoded pagination and filter query are used as is
+// for older clients, otherwise plain query params are bound into pagination and filter and encoded the same way
+func bindListQuery(ctx *gin.Context, pagination, filter interface{}) (paginationString, filterString string, err error) {
+	paginationString, filterString = ctx.Query("pagination"), ctx.Query("filter")
+
+	if paginationString == "" && hasAnyQuery(ctx, PLAIN_PAGINATION_PARAMS) {
+		paginationString, err = bindAndEncodeQuery(ctx, pagination)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	if filterString == "" {
+		filterString, err = bindAndEncodeQuery(ctx, filter)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return paginationString, filterString, nil
+}
+
+// bindAndEncodeQuery bind plain query params into v, empty string is returned when no field is set
+func bindAndEncodeQuery(ctx *gin.Context, v interface{}) (string, error) {
+	err := ctx.ShouldBindQuery(v)
+	if err != nil {
+		return "", err
+	}
+
+	if reflect.ValueOf(v).Elem().IsZero() {
+		return "", nil
+	}
+
+	return urlutils.EncodeStruct(v)
+}
+
+func hasAnyQuery(ctx *gin.Context, keys []string) bool {
+	for _, key := range keys {
+		if _, ok := ctx.GetQuery(key); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// respondInvalidQuery answer 400 when usecase rejected list query of the request, ex. sort on column which is not allowed,
+// unknown status on filter or tampered cursor.
+// It return false and write nothing for every other error
+func respondInvalidQuery(ctx *gin.Context, err error) bool {
+	var message string
+	switch {
+	case errors.Is(err, dbutils.ErrInvalidSort):
+		message = "Invalid Sort, Please check sort fields are allowed and not repeated"
+	case errors.Is(err, usecase.ErrInvalidFilter):
+		message = "Invalid Filter, Please check status, match modes and date ranges of filter"
+	case errors.Is(err, usecase.ErrInvalidCursor):
+		message = "Invalid Cursor, Please use next_cursor or prev_cursor of previous page as is, without sort"
+	default:
+		return false
+	}
+
+	ctx.JSON(http.StatusBadRequest, response.ErrorResponse{
+		Success: false,
+		Message: message,
+		Type:    0,
+		Data:    nil,
+	})
+	return true
+}
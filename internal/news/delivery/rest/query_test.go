@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_BindListQuery(t *testing.T) {
+	createdFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	encodedPagination, _ := urlutils.EncodeStruct(presentation.Pagination{Offset: 0, Count: 1})
+	encodedFilter, _ := urlutils.EncodeStruct(presentation.NewsFilter{Status: 1})
+
+	testcases := []struct {
+		name           string
+		url            string
+		mustPagination *presentation.Pagination
+		mustFilter     *presentation.NewsFilter
+		mustErr        bool
+	}{
+		{
+			name: "Success - Empty Query",
+			url:  "/news",
+		},
+		{
+			name:           "Success - Encoded Query Kept",
+			url:            fmt.Sprintf("/news?pagination=%s&filter=%s&limit=5&status=2", encodedPagination, encodedFilter),
+			mustPagination: &presentation.Pagination{Offset: 0, Count: 1},
+			mustFilter:     &presentation.NewsFilter{Status: 1},
+		},
+		{
+			name:           "Success - Plain Query",
+			url:            "/news?status=2&topic=1&topic=3&limit=20&offset=40&title=foo&topics_match=all&created_from=2026-01-01T00:00:00Z",
+			mustPagination: &presentation.Pagination{Offset: 40, Count: 20},
+			mustFilter: &presentation.NewsFilter{
+				Statuses:    []int{2},
+				Topics:      []int{1, 3},
+				Title:       "foo",
+				TopicsMatch: presentation.FILTER_MATCH_ALL,
+				CreatedFrom: &createdFrom,
+			},
+		},
+		{
+			name:           "Success - Plain Pagination Only",
+			url:            "/news?limit=10",
+			mustPagination: &presentation.Pagination{Offset: 0, Count: 10},
+		},
+		{
+			name:           "Success - Unknown Query Ignored",
+			url:            "/news?limit=10&utm_source=mail&status=2",
+			mustPagination: &presentation.Pagination{Offset: 0, Count: 10},
+			mustFilter:     &presentation.NewsFilter{Statuses: []int{2}},
+		},
+		{
+			name:    "Failed - Invalid Plain Value",
+			url:     "/news?limit=10&status=abc",
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Invalid Plain Time",
+			url:     "/news?limit=10&created_from=yesterday",
+			mustErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request, _ = http.NewRequest("GET", tc.url, nil)
+
+			paginationString, filterString, err := bindListQuery(ctx, &presentation.Pagination{}, &presentation.NewsFilter{})
+
+			var gotPagination *presentation.Pagination
+			var gotFilter *presentation.NewsFilter
+			if paginationString != "" {
+				_ = urlutils.DecodeEncodedString(paginationString, &gotPagination)
+			}
+			if filterString != "" {
+				_ = urlutils.DecodeEncodedString(filterString, &gotFilter)
+			}
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(gotPagination, tc.mustPagination) || !reflect.DeepEqual(gotFilter, tc.mustFilter) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_BindListQuery",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %+v %+v, expected %+v %+v, mustErr %v, err %v", gotPagination, gotFilter, tc.mustPagination, tc.mustFilter, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -1,10 +1,11 @@
 package news
 
 import (
+	"context"
 	"github.com/Mufidzz/bareksa-test/internal/news/delivery/rest"
 	"github.com/Mufidzz/bareksa-test/internal/news/usecase"
 	"github.com/Mufidzz/bareksa-test/internal/repository/postgre"
-	"github.com/Mufidzz/bareksa-test/internal/repository/redis"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,19 +13,36 @@ type Domain struct {
 	Usecase *usecase.Usecase
 }
 
-func StartHTTP(router *gin.Engine, postgre *postgre.Postgre, redisRepo *redis.Redis) *Domain {
+// StartHTTP wire news domain, cacheRepo is any cache backend, ex. Redis, in-process memory or no-op.
+// Routes are protected by guard, see rest.HTTPHandler.SetRoutes for role of each route
+func StartHTTP(router *gin.Engine, postgre *postgre.Postgre, cacheRepo usecase.NewsRedisRepository, guard *auth.Guard, options usecase.Options) *Domain {
 	uc := usecase.New(&usecase.Repositories{
 		NewsDataRepository:        postgre,
 		NewsTopicDataRepository:   postgre,
 		NewsTagDataRepository:     postgre,
+		NewsAuthorDataRepository:  postgre,
 		AssignNewsAssocRepository: postgre,
-		NewsRedisRepository:       redisRepo,
-	})
+		NewsWorkflowRepository:    postgre,
+		NewsRevisionRepository:    postgre,
+		TransactionRepository:     postgreTransaction{postgre},
+		NewsRedisRepository:       cacheRepo,
+	}, options)
 
-	httpHandler := rest.NewHTTP(router, uc, uc, uc)
-	httpHandler.SetRoutes()
+	httpHandler := rest.NewHTTP(router, uc, uc, uc, uc)
+	httpHandler.SetRoutes(guard)
 
 	return &Domain{
 		Usecase: uc,
 	}
 }
+
+// postgreTransaction adapt postgre.Postgre WithTx into usecase.TransactionRepository
+type postgreTransaction struct {
+	postgre *postgre.Postgre
+}
+
+func (pt postgreTransaction) WithTx(ctx context.Context, fn func(tx usecase.TxRepositories) error) error {
+	return pt.postgre.WithTx(ctx, func(tx *postgre.Postgre) error {
+		return fn(tx)
+	})
+}
@@ -0,0 +1,45 @@
+package news
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"log"
+	"time"
+)
+
+// StartPurgeDeletedNews run in background and hard delete news soft deleted longer than retention, every interval until ctx is done.
+// Interval 0 disable the job
+func (d *Domain) StartPurgeDeletedNews(ctx context.Context, interval, retention time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purgedID, err := d.Usecase.PurgeDeletedNews(ctx, retention)
+				if err != nil {
+					logger.Error(response.InternalError{
+						Type:         "Job",
+						Name:         "News",
+						FunctionName: "StartPurgeDeletedNews",
+						Description:  "failed purge deleted news",
+						Trace:        err,
+					}.Error())
+					continue
+				}
+
+				if len(purgedID) > 0 {
+					log.Printf("[Purge Job] purged %d deleted news %v", len(purgedID), purgedID)
+				}
+			}
+		}
+	}()
+}
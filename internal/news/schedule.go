@@ -0,0 +1,50 @@
+package news
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"log"
+	"time"
+)
+
+// StartNewsSchedule run in background and publish or unpublish news whose schedule has come, every interval until ctx is done.
+// Every instance may start it, only the one holding the schedule lock make the transitions on each run.
+// Interval 0 disable the job
+func (d *Domain) StartNewsSchedule(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				published, unpublished, err := d.Usecase.RunNewsSchedule(ctx)
+				if err != nil {
+					logger.Error(response.InternalError{
+						Type:         "Job",
+						Name:         "News",
+						FunctionName: "StartNewsSchedule",
+						Description:  "failed run news schedule",
+						Trace:        err,
+					}.Error())
+					continue
+				}
+
+				if len(published) > 0 {
+					log.Printf("[Schedule Job] published %d news %v", len(published), published)
+				}
+
+				if len(unpublished) > 0 {
+					log.Printf("[Schedule Job] unpublished %d news %v", len(unpublished), unpublished)
+				}
+			}
+		}
+	}()
+}
@@ -1,12 +1,17 @@
 package usecase
 
-import "github.com/Mufidzz/bareksa-test/presentation"
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
 
 type MockAssignNewsAssocRepository struct {
-	createBulkNewsTopicsAssoc createBulkNewsTopicsAssoc
-	createBulkNewsTagsAssoc   createBulkNewsTagsAssoc
-	cleanNewsTopicsAssoc      cleanNewsTopicsAssoc
-	cleanNewsTagsAssoc        cleanNewsTagsAssoc
+	createBulkNewsTopicsAssoc  createBulkNewsTopicsAssoc
+	createBulkNewsTagsAssoc    createBulkNewsTagsAssoc
+	cleanNewsTopicsAssoc       cleanNewsTopicsAssoc
+	cleanNewsTagsAssoc         cleanNewsTagsAssoc
+	createBulkNewsAuthorsAssoc createBulkNewsAuthorsAssoc
+	cleanNewsAuthorsAssoc      cleanNewsAuthorsAssoc
 }
 
 type createBulkNewsTopicsAssoc struct {
@@ -25,16 +30,31 @@ type cleanNewsTagsAssoc struct {
 	err error
 }
 
-func (manar *MockAssignNewsAssocRepository) CreateBulkNewsTopicsAssoc(in []presentation.CreateNewsTopicsAssoc) (err error) {
+type createBulkNewsAuthorsAssoc struct {
+	err error
+}
+
+type cleanNewsAuthorsAssoc struct {
+	err error
+}
+
+func (manar *MockAssignNewsAssocRepository) CreateBulkNewsTopicsAssoc(ctx context.Context, in []presentation.CreateNewsTopicsAssoc) (err error) {
 	return manar.createBulkNewsTopicsAssoc.err
 }
-func (manar *MockAssignNewsAssocRepository) CreateBulkNewsTagsAssoc(in []presentation.CreateNewsTagsAssoc) (err error) {
+func (manar *MockAssignNewsAssocRepository) CreateBulkNewsTagsAssoc(ctx context.Context, in []presentation.CreateNewsTagsAssoc) (err error) {
 	return manar.createBulkNewsTagsAssoc.err
 }
 
-func (manar *MockAssignNewsAssocRepository) CleanNewsTopicsAssoc(newsID []int) (err error) {
+func (manar *MockAssignNewsAssocRepository) CleanNewsTopicsAssoc(ctx context.Context, newsID []int) (err error) {
 	return manar.cleanNewsTopicsAssoc.err
 }
-func (manar *MockAssignNewsAssocRepository) CleanNewsTagAssoc(newsID []int) (err error) {
+func (manar *MockAssignNewsAssocRepository) CleanNewsTagAssoc(ctx context.Context, newsID []int) (err error) {
 	return manar.cleanNewsTagsAssoc.err
 }
+
+func (manar *MockAssignNewsAssocRepository) CreateBulkNewsAuthorsAssoc(ctx context.Context, in []presentation.CreateNewsAuthorsAssoc) (err error) {
+	return manar.createBulkNewsAuthorsAssoc.err
+}
+func (manar *MockAssignNewsAssocRepository) CleanNewsAuthorsAssoc(ctx context.Context, newsID []int) (err error) {
+	return manar.cleanNewsAuthorsAssoc.err
+}
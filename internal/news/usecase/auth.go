@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+// authorizeNewsChange allow editor and above to change any news, and writer only news it created.
+// Request without principal is allowed, it only reach usecase when authentication is disabled
+func (uc *Usecase) authorizeNewsChange(ctx context.Context, functionName string, newsID int) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Role.Allows(auth.ROLE_EDITOR) {
+		return nil
+	}
+
+	// Owner is read from primary, lagging replica could still show news the writer has just lost or gained
+	news, _, err := uc.repositories.GetBulkNews(dbutils.WithPrimaryRead(ctx), presentation.Pagination{
+		Offset: 0,
+		Count:  1,
+	}, &presentation.NewsFilter{NewsID: newsID}, "")
+	if err != nil {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: functionName,
+			Description:  "Failed reading news owner",
+			Trace:        err,
+		}.Error()
+	}
+
+	// Missing news is reported as forbidden too, so writer can not probe news it can not change
+	if len(news) <= 0 || news[0].CreatedBy == nil || *news[0].CreatedBy != principal.Subject {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: functionName,
+			Description:  "News is not owned by " + principal.Subject,
+			Trace:        auth.ErrForbidden,
+		}.Error()
+	}
+
+	return nil
+}
+
+// liveOnly tell whether request may only see live news, see presentation.NewsFilter Live. Public readers, anonymous or
+// with ROLE_READER, do not see news before it is published or outside its publication window.
+// Request without principal only happen when authentication is disabled, it see every news
+func liveOnly(ctx context.Context) bool {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	return ok && !principal.Role.Allows(auth.ROLE_WRITER)
+}
+
+// withLiveOnly return filter restricted to live news when live is true, nil filter is replaced
+func withLiveOnly(filter *presentation.NewsFilter, live bool) *presentation.NewsFilter {
+	if !live {
+		return filter
+	}
+
+	if filter == nil {
+		filter = &presentation.NewsFilter{}
+	}
+
+	filter.Live = true
+	return filter
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"testing"
+)
+
+func Test_LiveOnly(t *testing.T) {
+	testcases := []struct {
+		name       string
+		principal  *auth.Principal
+		filter     *presentation.NewsFilter
+		mustReturn *presentation.NewsFilter
+	}{
+		{
+			name:       "Success - Authentication Disabled",
+			filter:     nil,
+			mustReturn: nil,
+		},
+		{
+			name:       "Success - Anonymous Reader",
+			principal:  &auth.Principal{Role: auth.ROLE_READER},
+			filter:     nil,
+			mustReturn: &presentation.NewsFilter{Live: true},
+		},
+		{
+			name:       "Success - Reader Keep Filter",
+			principal:  &auth.Principal{Subject: "reader-1", Role: auth.ROLE_READER},
+			filter:     &presentation.NewsFilter{Title: "a"},
+			mustReturn: &presentation.NewsFilter{Title: "a", Live: true},
+		},
+		{
+			name:       "Success - Writer See Embargoed News",
+			principal:  &auth.Principal{Subject: "writer-1", Role: auth.ROLE_WRITER},
+			filter:     &presentation.NewsFilter{Title: "a"},
+			mustReturn: &presentation.NewsFilter{Title: "a"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			ctx := context.Background()
+			if tc.principal != nil {
+				ctx = auth.WithPrincipal(ctx, *tc.principal)
+			}
+
+			got := withLiveOnly(tc.filter, liveOnly(ctx))
+
+			if !reflect.DeepEqual(got, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_LiveOnly",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %+v, expected %+v", got, tc.mustReturn),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/singleflight"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// buildCacheKey derive cache key from decoded request params, so equivalent requests share one entry
+// whatever their query order or encoding, and fields unknown to the params never reach the key
+func buildCacheKey(namespace string, params ...interface{}) (string, error) {
+	paramsJson, err := json.Marshal(params)
+	if err != nil {
+		return "", response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: "buildCacheKey",
+			Description:  "Failed Marshall cache key params",
+			Trace:        err,
+		}.Error()
+	}
+
+	hash := sha1.Sum(paramsJson)
+	return fmt.Sprintf(CACHE_KEY_FORMAT, CACHE_KEY_VERSION, namespace, hex.EncodeToString(hash[:])), nil
+}
+
+// normalizeNewsFilter sort id lists and move times to UTC, filter meaning is unchanged but equivalent filters build the same cache key
+func normalizeNewsFilter(filter *presentation.NewsFilter) {
+	if filter == nil {
+		return
+	}
+
+	for _, id := range [][]int{filter.Topics, filter.Tags, filter.Authors, filter.Statuses, filter.NewsIDs} {
+		sort.Ints(id)
+	}
+
+	for _, t := range []*time.Time{filter.CreatedFrom, filter.CreatedTo, filter.UpdatedFrom, filter.UpdatedTo} {
+		if t != nil {
+			*t = t.UTC()
+		}
+	}
+}
+
+// normalizeSearchQuery collapse whitespace, websearch syntax does not depend on it
+func normalizeSearchQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
+
+// newsCacheTags return tags of cached news, topic, tag and author names are part of news response so renaming them must evict it too
+func newsCacheTags(news []presentation.GetNewsResponse, withNewsID bool) []string {
+	seen := map[string]bool{}
+	var tags []string
+
+	add := func(tag string) {
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	for _, n := range news {
+		if withNewsID {
+			add(fmt.Sprintf(CACHE_TAG_NEWS, n.ID))
+		}
+
+		for _, topic := range n.Topics {
+			add(fmt.Sprintf(CACHE_TAG_NEWS_TOPIC, topic.ID))
+		}
+
+		for _, tag := range n.Tags {
+			add(fmt.Sprintf(CACHE_TAG_NEWS_TAG, tag.ID))
+		}
+
+		for _, author := range n.Authors {
+			add(fmt.Sprintf(CACHE_TAG_NEWS_AUTHOR, author.ID))
+		}
+	}
+
+	return tags
+}
+
+// idCacheTags format each id with tagFormat, ex. CACHE_TAG_NEWS
+func idCacheTags(tagFormat string, id []int) []string {
+	tags := make([]string, 0, len(id))
+	for _, i := range id {
+		tags = append(tags, fmt.Sprintf(tagFormat, i))
+	}
+
+	return tags
+}
+
+// invalidateCache evict cached keys saved under the tags, failure is only logged since the write itself already succeeded
+func (uc *Usecase) invalidateCache(ctx context.Context, functionName string, tags ...string) {
+	err := uc.repositories.InvalidateTags(ctx, tags...)
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: functionName,
+			Description:  "Failed invalidate redis cache",
+			Trace:        err,
+		}.Error())
+	}
+}
+
+// cacheLoader load fresh value of cached read, together with the tags it is saved under
+type cacheLoader func(ctx context.Context) (value interface{}, tags []string, err error)
+
+// cachedRead fill dest from cache, or through load when missing. Concurrent misses of the same key share single load
+// running on request of the first caller, and when Options.StaleWhileRevalidate is set stale entry is served while
+// one background load refresh it. dest must be pointer to the type of value returned by load
+func (uc *Usecase) cachedRead(ctx context.Context, functionName, cacheKey string, dest interface{}, load cacheLoader) error {
+	stale, err := uc.repositories.GetObject(ctx, cacheKey, dest)
+	if err == nil && !stale {
+		return nil
+	}
+
+	// Miss is expected and open circuit was reported when it opened, only other failure is reported.
+	// Either way the value is loaded from Database
+	if err != nil && !errors.Is(err, cache.ErrCacheMiss) && !errors.Is(err, cache.ErrCircuitOpen) {
+		logger.Error(response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: functionName,
+			Description:  "Failed get data from redis",
+			Trace:        err,
+		}.Error())
+	}
+
+	if err == nil && uc.options.StaleWhileRevalidate {
+		if !uc.flight.InFlight(cacheKey) {
+			go uc.refreshCache(functionName, cacheKey, load)
+		}
+
+		return nil
+	}
+
+	for {
+		ran := false
+		resultChan := uc.flight.DoChan(cacheKey, func() (interface{}, error) {
+			ran = true
+			return uc.loadAndCache(ctx, functionName, cacheKey, load)
+		})
+
+		var result singleflight.Result
+		select {
+		case <-ctx.Done():
+			// Load keep running for other callers, it is cancelled by its own request only
+			return ctx.Err()
+		case result = <-resultChan:
+		}
+
+		// Load ran on request of other caller which has gone, this request is still alive so it load again
+		if !ran && ctx.Err() == nil && (errors.Is(result.Err, context.Canceled) || errors.Is(result.Err, context.DeadlineExceeded)) {
+			continue
+		}
+
+		if result.Err != nil {
+			return result.Err
+		}
+
+		// value is shared with every coalesced caller, it must be treated as read only
+		reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(result.Val))
+		return nil
+	}
+}
+
+// refreshCache reload stale entry in background, the request which found it stale is already served
+func (uc *Usecase) refreshCache(functionName, cacheKey string, load cacheLoader) {
+	ctx, cancel := context.WithTimeout(context.Background(), CACHE_REFRESH_TIMEOUT)
+	defer cancel()
+
+	_, err := uc.flight.Do(cacheKey, func() (interface{}, error) {
+		return uc.loadAndCache(ctx, functionName, cacheKey, load)
+	})
+	if err != nil {
+		logger.Error(response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: functionName,
+			Description:  "Failed refresh stale cache",
+			Trace:        err,
+		}.Error())
+	}
+}
+
+func (uc *Usecase) loadAndCache(ctx context.Context, functionName, cacheKey string, load cacheLoader) (interface{}, error) {
+	value, tags, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.repositories.SaveObject(ctx, cacheKey, value, tags...)
+	if err != nil && !errors.Is(err, cache.ErrCircuitOpen) {
+		logger.Error(response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: functionName,
+			Description:  "Failed Store data to redis",
+			Trace:        err,
+		}.Error())
+	}
+
+	return value, nil
+}
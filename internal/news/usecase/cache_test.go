@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_NewsCacheTags(t *testing.T) {
+	testcases := []struct {
+		name       string
+		news       []presentation.GetNewsResponse
+		withNewsID bool
+		mustReturn []string
+	}{
+		{
+			name:       "Success - Empty",
+			mustReturn: nil,
+		},
+		{
+			name: "Success - Single News",
+			news: []presentation.GetNewsResponse{
+				{ID: 1, Topics: presentation.NewsAssocItems{{ID: 2}}, Tags: presentation.NewsAssocItems{{ID: 3}}, Authors: presentation.NewsAssocItems{{ID: 4}}},
+			},
+			withNewsID: true,
+			mustReturn: []string{"news:1", "news-topic:2", "news-tag:3", "news-author:4"},
+		},
+		{
+			name: "Success - List Without News ID, Duplicate Removed",
+			news: []presentation.GetNewsResponse{
+				{ID: 1, Topics: presentation.NewsAssocItems{{ID: 2}}},
+				{ID: 2, Topics: presentation.NewsAssocItems{{ID: 2}, {ID: 4}}, Tags: presentation.NewsAssocItems{{ID: 2}}},
+			},
+			mustReturn: []string{"news-topic:2", "news-topic:4", "news-tag:2"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := newsCacheTags(tc.news, tc.withNewsID)
+
+			if !reflect.DeepEqual(got, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_NewsCacheTags",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v", got, tc.mustReturn),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_BuildCacheKey(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	createdFrom := time.Date(2026, 1, 1, 7, 0, 0, 0, jakarta)
+	createdFromUTC := createdFrom.UTC()
+
+	decodeFilter := func(filterString string) *presentation.NewsFilter {
+		var filter *presentation.NewsFilter
+		_ = urlutils.DecodeEncodedString(filterString, &filter)
+		return filter
+	}
+
+	testcases := []struct {
+		name      string
+		a         *presentation.NewsFilter
+		b         *presentation.NewsFilter
+		mustEqual bool
+	}{
+		{
+			name:      "Success - Topic Order Ignored",
+			a:         decodeFilter("eyJ0b3BpY3MiOlsxLDNdfQ=="),
+			b:         decodeFilter("eyJ0b3BpY3MiOlszLDFdfQ=="),
+			mustEqual: true,
+		},
+		{
+			name:      "Success - Unknown Field Ignored",
+			a:         decodeFilter("eyJ0aXRsZSI6ImEifQ=="),
+			b:         decodeFilter("eyJ0aXRsZSI6ImEiLCJmb28iOiJiYXIifQ=="),
+			mustEqual: true,
+		},
+		{
+			name:      "Success - Time Zone Ignored",
+			a:         &presentation.NewsFilter{CreatedFrom: &createdFrom},
+			b:         &presentation.NewsFilter{CreatedFrom: &createdFromUTC},
+			mustEqual: true,
+		},
+		{
+			name:      "Success - Different Filter",
+			a:         decodeFilter("eyJ0b3BpY3MiOlsxLDNdfQ=="),
+			b:         decodeFilter("eyJ0YWdzIjpbMSwzXX0="),
+			mustEqual: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			normalizeNewsFilter(tc.a)
+			normalizeNewsFilter(tc.b)
+
+			pagination := presentation.Pagination{Count: 10}
+			keyA, errA := buildCacheKey(CACHE_NAMESPACE_NEWS, pagination, tc.a, "")
+			keyB, errB := buildCacheKey(CACHE_NAMESPACE_NEWS, pagination, tc.b, "")
+
+			if errA != nil || errB != nil || (keyA == keyB) != tc.mustEqual || !strings.HasPrefix(keyA, CACHE_KEY_VERSION+":"+CACHE_NAMESPACE_NEWS+":") {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_BuildCacheKey",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v and %v, mustEqual %v, err %v %v", keyA, keyB, tc.mustEqual, errA, errB),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_CachedRead(t *testing.T) {
+	testcases := []struct {
+		name       string
+		getObject  getObject
+		options    Options
+		callers    int
+		loadErr    error
+		mustLoads  int32
+		mustReturn string
+		mustErr    bool
+	}{
+		{
+			name:       "Success - Fresh Hit Not Loaded",
+			getObject:  getObject{},
+			callers:    5,
+			mustLoads:  0,
+			mustReturn: "",
+		},
+		{
+			name:       "Success - Concurrent Miss Coalesced",
+			getObject:  getObject{err: cache.ErrCacheMiss},
+			callers:    10,
+			mustLoads:  1,
+			mustReturn: "A",
+		},
+		{
+			name:       "Success - Cache Error Loaded From Database",
+			getObject:  getObject{err: fmt.Errorf("wrapped %w", cache.ErrCacheUnavailable)},
+			callers:    10,
+			mustLoads:  1,
+			mustReturn: "A",
+		},
+		{
+			name:       "Success - Stale Loaded When Revalidate Disabled",
+			getObject:  getObject{stale: true},
+			callers:    1,
+			mustLoads:  1,
+			mustReturn: "A",
+		},
+		{
+			name:       "Success - Stale Served While Revalidate",
+			getObject:  getObject{stale: true},
+			options:    Options{StaleWhileRevalidate: true},
+			callers:    5,
+			mustLoads:  1,
+			mustReturn: "",
+		},
+		{
+			name:      "Failed - Load Error",
+			getObject: getObject{err: fmt.Errorf("miss")},
+			callers:   3,
+			loadErr:   fmt.Errorf("hello"),
+			mustLoads: 1,
+			mustErr:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: &Repositories{NewsRedisRepository: &MockNewsRedisRepository{getObject: tc.getObject}},
+				options:      tc.options,
+			}
+
+			var loads int32
+			release := make(chan struct{})
+			load := func(ctx context.Context) (interface{}, []string, error) {
+				atomic.AddInt32(&loads, 1)
+				<-release
+				return "A", nil, tc.loadErr
+			}
+
+			var wg sync.WaitGroup
+			results := make(chan error, tc.callers)
+			for i := 0; i < tc.callers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					var got string
+					err := uc.cachedRead(context.Background(), "Test_CachedRead", "key", &got, load)
+					if err == nil && got != tc.mustReturn {
+						err = fmt.Errorf("got %v, expected %v", got, tc.mustReturn)
+					}
+					results <- err
+				}()
+			}
+
+			// Give every caller time to join the running load before releasing it
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+			wg.Wait()
+			close(results)
+
+			// Revalidation run in background, wait until it finish
+			for i := 0; i < 100 && uc.flight.InFlight("key"); i++ {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			for err := range results {
+				if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+					tt.Error(response.InternalTestError{
+						Name:         tt.Name(),
+						FunctionName: "Test_CachedRead",
+						Description:  "Testcase run unsuccessfully",
+						Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+					}.Error())
+				}
+			}
+
+			if atomic.LoadInt32(&loads) != tc.mustLoads {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CachedRead",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("loaded %d times, expected %d", loads, tc.mustLoads),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_CachedReadLeaderCancelled(t *testing.T) {
+	uc := Usecase{
+		repositories: &Repositories{NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: cache.ErrCacheMiss}}},
+	}
+
+	var startOnce sync.Once
+	started := make(chan struct{})
+	release := make(chan struct{})
+	load := func(ctx context.Context) (interface{}, []string, error) {
+		startOnce.Do(func() { close(started) })
+		select {
+		case <-release:
+			return "A", nil, nil
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderResult := make(chan error, 1)
+	go func() {
+		var got string
+		leaderResult <- uc.cachedRead(leaderCtx, "Test_CachedReadLeaderCancelled", "key", &got, load)
+	}()
+	<-started
+
+	waiterResult := make(chan error, 1)
+	var got string
+	go func() {
+		waiterResult <- uc.cachedRead(context.Background(), "Test_CachedReadLeaderCancelled", "key", &got, load)
+	}()
+
+	// Leader client disconnect while waiter is coalesced on its load, the load is cancelled with it
+	time.Sleep(50 * time.Millisecond)
+	cancelLeader()
+	if err := <-leaderResult; !errors.Is(err, context.Canceled) {
+		t.Error(response.InternalTestError{
+			Name:         t.Name(),
+			FunctionName: "Test_CachedReadLeaderCancelled",
+			Description:  "Testcase run unsuccessfully",
+			Trace:        fmt.Sprintf("leader err %v, expected %v", err, context.Canceled),
+		}.Error())
+	}
+
+	// Waiter load again on its own request
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	err := <-waiterResult
+	if err != nil || got != "A" {
+		t.Error(response.InternalTestError{
+			Name:         t.Name(),
+			FunctionName: "Test_CachedReadLeaderCancelled",
+			Description:  "Testcase run unsuccessfully",
+			Trace:        fmt.Sprintf("got %v, expected A, err %v", got, err),
+		}.Error())
+	}
+}
+
+func Test_CachedReadWaiterCancelled(t *testing.T) {
+	uc := Usecase{
+		repositories: &Repositories{NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: cache.ErrCacheMiss}}},
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	load := func(ctx context.Context) (interface{}, []string, error) {
+		close(started)
+		<-release
+		return "A", nil, nil
+	}
+
+	leaderResult := make(chan error, 1)
+	var leaderGot string
+	go func() {
+		leaderResult <- uc.cachedRead(context.Background(), "Test_CachedReadWaiterCancelled", "key", &leaderGot, load)
+	}()
+	<-started
+
+	// Waiter stop waiting as soon as its own request is gone, while the load keep running for the leader
+	waiterCtx, cancelWaiter := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelWaiter()
+
+	var got string
+	err := uc.cachedRead(waiterCtx, "Test_CachedReadWaiterCancelled", "key", &got, load)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error(response.InternalTestError{
+			Name:         t.Name(),
+			FunctionName: "Test_CachedReadWaiterCancelled",
+			Description:  "Testcase run unsuccessfully",
+			Trace:        fmt.Sprintf("waiter err %v, expected %v", err, context.DeadlineExceeded),
+		}.Error())
+	}
+
+	close(release)
+	if err := <-leaderResult; err != nil || leaderGot != "A" {
+		t.Error(response.InternalTestError{
+			Name:         t.Name(),
+			FunctionName: "Test_CachedReadWaiterCancelled",
+			Description:  "Testcase run unsuccessfully",
+			Trace:        fmt.Sprintf("got %v, expected A, err %v", leaderGot, err),
+		}.Error())
+	}
+}
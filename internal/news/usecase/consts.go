@@ -0,0 +1,30 @@
+package usecase
+
+import "time"
+
+// CACHE_KEY_VERSION is part of every cache key, bump it when cached response shape change so older entries are never read
+const CACHE_KEY_VERSION = "v3"
+
+// CACHE_KEY_FORMAT is <version>:<namespace>:<hash of normalized request params>
+const CACHE_KEY_FORMAT = "%s:%s:%s"
+
+// Cache key namespaces for cached read results
+const CACHE_NAMESPACE_SINGLE_NEWS = "news:single"
+const CACHE_NAMESPACE_NEWS = "news:list"
+const CACHE_NAMESPACE_NEWS_SEARCH = "news:search"
+const CACHE_NAMESPACE_NEWS_TOPICS = "news-topic:list"
+const CACHE_NAMESPACE_NEWS_TAGS = "news-tag:list"
+const CACHE_NAMESPACE_NEWS_AUTHORS = "news-author:list"
+
+// Cache tags, every cached key is saved under the tags it depend on so writes only evict affected keys
+const CACHE_TAG_NEWS = "news:%d"
+const CACHE_TAG_NEWS_LIST = "news:list"
+const CACHE_TAG_NEWS_TOPIC = "news-topic:%d"
+const CACHE_TAG_NEWS_TOPIC_LIST = "news-topic:list"
+const CACHE_TAG_NEWS_TAG = "news-tag:%d"
+const CACHE_TAG_NEWS_TAG_LIST = "news-tag:list"
+const CACHE_TAG_NEWS_AUTHOR = "news-author:%d"
+const CACHE_TAG_NEWS_AUTHOR_LIST = "news-author:list"
+
+// CACHE_REFRESH_TIMEOUT bound background refresh of stale cache entry, it is detached from the request already served
+const CACHE_REFRESH_TIMEOUT = time.Duration(10) * time.Second
@@ -0,0 +1,18 @@
+package usecase
+
+import "errors"
+
+// ErrNewsNotFound is returned when news to change does not exist
+var ErrNewsNotFound = errors.New("news not found")
+
+// ErrRevisionNotFound is returned when requested revision does not exist on the news
+var ErrRevisionNotFound = errors.New("news revision not found")
+
+// ErrInvalidFilter is returned when news filter can not be decoded or does not pass presentation.NewsFilter Validate
+var ErrInvalidFilter = errors.New("invalid news filter")
+
+// ErrInvalidCursor is returned when pagination cursor is malformed, or combined with sort which keyset paging can not follow
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// ErrIllegalTransition is returned when news status can not be changed as requested from its current status
+var ErrIllegalTransition = errors.New("illegal news status transition")
@@ -1,19 +1,39 @@
 package usecase
 
+import "github.com/Mufidzz/bareksa-test/pkg/singleflight"
+
 type Repositories struct {
 	NewsDataRepository
 	NewsTopicDataRepository
 	NewsTagDataRepository
+	NewsAuthorDataRepository
 	AssignNewsAssocRepository
+	NewsWorkflowRepository
+	NewsRevisionRepository
+	TransactionRepository
 	NewsRedisRepository
 }
 
+// Options keep response shape compatible with older consumers
+type Options struct {
+	// LegacyAssocNames keep comma joined TopicsName and TagsName on news response, beside structured Topics and Tags
+	LegacyAssocNames bool
+
+	// StaleWhileRevalidate serve cached read past its soft TTL while single background load refresh it
+	StaleWhileRevalidate bool
+}
+
 type Usecase struct {
 	repositories *Repositories
+	options      Options
+
+	// flight coalesce concurrent cache miss of the same key into single load
+	flight singleflight.Group
 }
 
-func New(repositories *Repositories) *Usecase {
+func New(repositories *Repositories, options Options) *Usecase {
 	return &Usecase{
 		repositories: repositories,
+		options:      options,
 	}
 }
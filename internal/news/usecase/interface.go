@@ -1,37 +1,85 @@
 package usecase
 
-import "github.com/Mufidzz/bareksa-test/presentation"
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"time"
+)
 
 type NewsDataRepository interface {
-	CreateBulkNews(in []presentation.CreateNewsRequest) (insertedID []int, err error)
-	GetBulkNews(pagination presentation.Pagination, filter *presentation.NewsFilter) (res []presentation.GetNewsResponse, err error)
-	UpdateBulkNews(in []presentation.UpdateNewsRequest) (updatedID []int, err error)
-	DeleteBulkNews(newsID []int) (deletedID []int, err error)
+	CreateBulkNews(ctx context.Context, in []presentation.CreateNewsRequest) (insertedID []int, err error)
+	GetBulkNews(ctx context.Context, pagination presentation.Pagination, filter *presentation.NewsFilter, sort string) (res []presentation.GetNewsResponse, total int64, err error)
+	SearchNews(ctx context.Context, query string, pagination presentation.Pagination, filter *presentation.NewsFilter) (res []presentation.SearchNewsResponse, total int64, err error)
+	UpdateBulkNews(ctx context.Context, in []presentation.UpdateNewsRequest) (updatedID []int, err error)
+	DeleteBulkNews(ctx context.Context, newsID []int) (deletedID []int, err error)
+	RestoreBulkNews(ctx context.Context, newsID []int) (restoredID []int, err error)
+	PurgeDeletedNews(ctx context.Context, deletedBefore time.Time) (purgedID []int, err error)
 }
 
 type NewsTopicDataRepository interface {
-	CreateBulkNewsTopics(in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error)
-	GetBulkNewsTopics(pagination *presentation.Pagination, filter *presentation.NewsTopicFilter) (res []presentation.GetNewsTopicsResponse, err error)
-	UpdateBulkNewsTopics(in []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error)
-	DeleteBulkNewsTopics(newsTopicID []int) (deletedID []int, err error)
+	CreateBulkNewsTopics(ctx context.Context, in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error)
+	GetBulkNewsTopics(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsTopicFilter, sort string) (res []presentation.GetNewsTopicsResponse, total int64, err error)
+	UpdateBulkNewsTopics(ctx context.Context, in []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error)
+	DeleteBulkNewsTopics(ctx context.Context, newsTopicID []int) (deletedID []int, err error)
 }
 
 type NewsTagDataRepository interface {
-	CreateBulkNewsTags(in []presentation.CreateNewsTagsRequest) (insertedID []int, err error)
-	GetBulkNewsTags(pagination *presentation.Pagination, filter *presentation.NewsTagsFilter) (res []presentation.GetNewsTagsResponse, err error)
-	UpdateBulkNewsTags(in []presentation.UpdateNewsTagsRequest) (updatedID []int, err error)
-	DeleteBulkNewsTags(newsTopicID []int) (deletedID []int, err error)
+	CreateBulkNewsTags(ctx context.Context, in []presentation.CreateNewsTagsRequest) (insertedID []int, err error)
+	GetBulkNewsTags(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsTagsFilter, sort string) (res []presentation.GetNewsTagsResponse, total int64, err error)
+	UpdateBulkNewsTags(ctx context.Context, in []presentation.UpdateNewsTagsRequest) (updatedID []int, err error)
+	DeleteBulkNewsTags(ctx context.Context, newsTopicID []int) (deletedID []int, err error)
+}
+
+type NewsAuthorDataRepository interface {
+	CreateBulkNewsAuthors(ctx context.Context, in []presentation.CreateNewsAuthorsRequest) (insertedID []int, err error)
+	GetBulkNewsAuthors(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsAuthorsFilter, sort string) (res []presentation.GetNewsAuthorsResponse, total int64, err error)
+	UpdateBulkNewsAuthors(ctx context.Context, in []presentation.UpdateNewsAuthorsRequest) (updatedID []int, err error)
+	DeleteBulkNewsAuthors(ctx context.Context, newsAuthorID []int) (deletedID []int, err error)
+}
+
+type NewsWorkflowRepository interface {
+	TransitionNewsStatus(ctx context.Context, newsID int, transition string, from []int, to int, transitionedBy string) (transitioned bool, err error)
+	GetNewsStatusTransitions(ctx context.Context, newsID int) (res []presentation.NewsStatusTransition, err error)
+
+	// TransitionDueNews move news whose schedule, presentation.NEWS_SCHEDULE_PUBLISH or NEWS_SCHEDULE_UNPUBLISH, has come
+	TransitionDueNews(ctx context.Context, schedule, transition string, from []int, to int, transitionedBy string) (transitionedID []int, err error)
+	// TryLockNewsSchedule elect the instance running news schedule until the transaction end, false mean other instance run it
+	TryLockNewsSchedule(ctx context.Context) (locked bool, err error)
+}
+
+type NewsRevisionRepository interface {
+	// GetNewsRevisions return revisions of news newest first, only the given revision numbers when any is given
+	GetNewsRevisions(ctx context.Context, newsID int, revision ...int) (res []presentation.NewsRevision, err error)
 }
 
 type AssignNewsAssocRepository interface {
-	CreateBulkNewsTopicsAssoc(in []presentation.CreateNewsTopicsAssoc) (err error)
-	CreateBulkNewsTagsAssoc(in []presentation.CreateNewsTagsAssoc) (err error)
-	CleanNewsTopicsAssoc(newsID []int) (err error)
-	CleanNewsTagAssoc(newsID []int) (err error)
+	CreateBulkNewsTopicsAssoc(ctx context.Context, in []presentation.CreateNewsTopicsAssoc) (err error)
+	CreateBulkNewsTagsAssoc(ctx context.Context, in []presentation.CreateNewsTagsAssoc) (err error)
+	CleanNewsTopicsAssoc(ctx context.Context, newsID []int) (err error)
+	CleanNewsTagAssoc(ctx context.Context, newsID []int) (err error)
+	CreateBulkNewsAuthorsAssoc(ctx context.Context, in []presentation.CreateNewsAuthorsAssoc) (err error)
+	CleanNewsAuthorsAssoc(ctx context.Context, newsID []int) (err error)
+}
+
+// TxRepositories are repositories bound to single database transaction
+type TxRepositories interface {
+	NewsDataRepository
+	NewsTopicDataRepository
+	NewsTagDataRepository
+	NewsAuthorDataRepository
+	AssignNewsAssocRepository
+	NewsWorkflowRepository
+}
+
+type TransactionRepository interface {
+	// WithTx commit everything done through tx when fn return nil, and roll it back otherwise
+	WithTx(ctx context.Context, fn func(tx TxRepositories) error) error
 }
 
 type NewsRedisRepository interface {
-	GetObject(key string, dest interface{}) error
-	SaveObject(key string, value interface{}) error
-	FlushAll() error
+	// GetObject fill dest with saved object, stale is true when object passed its soft TTL and should be refreshed
+	GetObject(ctx context.Context, key string, dest interface{}) (stale bool, err error)
+	SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error
+	// InvalidateTags evict every cached key saved under any of the tags
+	InvalidateTags(ctx context.Context, tags ...string) error
 }
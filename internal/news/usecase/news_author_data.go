@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+func (uc *Usecase) CreateNewsAuthors(ctx context.Context, in []presentation.CreateNewsAuthorsRequest) (insertedID []int, err error) {
+	insertedID, err = uc.repositories.CreateBulkNewsAuthors(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateCache(ctx, "CreateNewsAuthors", CACHE_TAG_NEWS_AUTHOR_LIST)
+	return insertedID, nil
+}
+
+// DeleteNewsAuthors evict the author listing and every cached news showing deleted author
+func (uc *Usecase) DeleteNewsAuthors(ctx context.Context, newsAuthorID []int) (deletedID []int, err error) {
+	deletedID, err = uc.repositories.DeleteBulkNewsAuthors(ctx, newsAuthorID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateCache(ctx, "DeleteNewsAuthors", append(idCacheTags(CACHE_TAG_NEWS_AUTHOR, newsAuthorID), CACHE_TAG_NEWS_AUTHOR_LIST)...)
+	return deletedID, nil
+}
+
+// UpdateNewsAuthors evict the author listing and every cached news showing updated author
+func (uc *Usecase) UpdateNewsAuthors(ctx context.Context, newNewsAuthors []presentation.UpdateNewsAuthorsRequest) (updatedID []int, err error) {
+	updatedID, err = uc.repositories.UpdateBulkNewsAuthors(ctx, newNewsAuthors)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]int, 0, len(newNewsAuthors))
+	for _, item := range newNewsAuthors {
+		id = append(id, item.ID)
+	}
+
+	uc.invalidateCache(ctx, "UpdateNewsAuthors", append(idCacheTags(CACHE_TAG_NEWS_AUTHOR, id), CACHE_TAG_NEWS_AUTHOR_LIST)...)
+	return updatedID, nil
+}
+
+func (uc *Usecase) GetNewsAuthors(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsAuthorsListResponse, err error) {
+	var pagination *presentation.Pagination
+	var filter *presentation.NewsAuthorsFilter
+
+	if paginationString != "" {
+		err = urlutils.DecodeEncodedString(paginationString, &pagination)
+		if err != nil {
+			return res, response.InternalError{
+				Type:         "Usecase",
+				Name:         "News Data",
+				FunctionName: "GetNewsAuthors",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+	}
+
+	if filterString != "" {
+		err = urlutils.DecodeEncodedString(filterString, &filter)
+		if err != nil {
+			return res, response.InternalError{
+				Type:         "Usecase",
+				Name:         "News Data",
+				FunctionName: "GetNewsAuthors",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+	}
+
+	cacheKey, err := buildCacheKey(CACHE_NAMESPACE_NEWS_AUTHORS, pagination, filter, sortString)
+	if err != nil {
+		return res, err
+	}
+
+	// Get From Redis First, then from Database
+	err = uc.cachedRead(ctx, "GetNewsAuthors", cacheKey, &res, func(ctx context.Context) (interface{}, []string, error) {
+		items, total, err := uc.repositories.GetBulkNewsAuthors(ctx, pagination, filter, sortString)
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "GetNewsAuthors",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+
+		// Cached together with pagination metadata
+		return presentation.GetNewsAuthorsListResponse{
+			Data: items,
+			Meta: presentation.NewPaginationMeta(pagination, len(items), total),
+		}, []string{CACHE_TAG_NEWS_AUTHOR_LIST}, nil
+	})
+	if err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
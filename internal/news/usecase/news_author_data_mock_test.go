@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+type MockNewsAuthorDataRepository struct {
+	createBulkNewsAuthors createBulkNewsAuthors
+	getBulkNewsAuthors    getBulkNewsAuthors
+	updateBulkNewsAuthors updateBulkNewsAuthors
+	deleteBulkNewsAuthors deleteBulkNewsAuthors
+}
+
+type createBulkNewsAuthors struct {
+	insertedID []int
+	err        error
+}
+
+type getBulkNewsAuthors struct {
+	res   []presentation.GetNewsAuthorsResponse
+	total int64
+	err   error
+}
+
+type updateBulkNewsAuthors struct {
+	updatedID []int
+	err       error
+}
+
+type deleteBulkNewsAuthors struct {
+	deletedID []int
+	err       error
+}
+
+func (mnadr *MockNewsAuthorDataRepository) CreateBulkNewsAuthors(ctx context.Context, in []presentation.CreateNewsAuthorsRequest) (insertedID []int, err error) {
+	return mnadr.createBulkNewsAuthors.insertedID, mnadr.createBulkNewsAuthors.err
+}
+func (mnadr *MockNewsAuthorDataRepository) GetBulkNewsAuthors(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsAuthorsFilter, sort string) (res []presentation.GetNewsAuthorsResponse, total int64, err error) {
+	return mnadr.getBulkNewsAuthors.res, mnadr.getBulkNewsAuthors.total, mnadr.getBulkNewsAuthors.err
+}
+func (mnadr *MockNewsAuthorDataRepository) UpdateBulkNewsAuthors(ctx context.Context, in []presentation.UpdateNewsAuthorsRequest) (updatedID []int, err error) {
+	return mnadr.updateBulkNewsAuthors.updatedID, mnadr.updateBulkNewsAuthors.err
+}
+func (mnadr *MockNewsAuthorDataRepository) DeleteBulkNewsAuthors(ctx context.Context, newsAuthorID []int) (deletedID []int, err error) {
+	return mnadr.deleteBulkNewsAuthors.deletedID, mnadr.deleteBulkNewsAuthors.err
+}
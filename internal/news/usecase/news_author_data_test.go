@@ -0,0 +1,618 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"testing"
+)
+
+func Test_CreateAuthors(t *testing.T) {
+	type inputParam struct {
+		in []presentation.CreateNewsAuthorsRequest
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		in         inputParam
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					createBulkNewsAuthors: createBulkNewsAuthors{
+						err: fmt.Errorf("ASD"),
+					},
+				},
+			},
+			in: inputParam{
+				in: []presentation.CreateNewsAuthorsRequest{
+					{
+						Name: "AAA",
+					},
+					{
+						Name: "BBB",
+					},
+				},
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Repo return no error",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					createBulkNewsAuthors: createBulkNewsAuthors{
+						insertedID: []int{1, 2},
+					},
+				},
+			},
+			in: inputParam{
+				in: []presentation.CreateNewsAuthorsRequest{
+					{
+						Name: "AAA",
+					},
+					{
+						Name: "BBB",
+					},
+				},
+			},
+			mustReturn: []int{1, 2},
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			got, err := uc.CreateNewsAuthors(context.Background(), tc.in.in)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_DeleteNewsAuthors(t *testing.T) {
+	type inputParam struct {
+		in []int
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		in         inputParam
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					deleteBulkNewsAuthors: deleteBulkNewsAuthors{
+						err: fmt.Errorf("ASD"),
+					},
+				},
+			},
+			in: inputParam{
+				in: []int{1, 2, 3, 4},
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Repo return no error, all deleted",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					deleteBulkNewsAuthors: deleteBulkNewsAuthors{
+						deletedID: []int{1, 2, 3, 4},
+					},
+				},
+			},
+			in: inputParam{
+				in: []int{1, 2, 3, 4},
+			},
+			mustReturn: []int{1, 2, 3, 4},
+			mustErr:    false,
+		},
+		{
+			name: "Success - Repo return no error, partially deleted",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					deleteBulkNewsAuthors: deleteBulkNewsAuthors{
+						deletedID: []int{1, 2},
+					},
+				},
+			},
+			in: inputParam{
+				in: []int{1, 2, 3, 4},
+			},
+			mustReturn: []int{1, 2},
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			got, err := uc.DeleteNewsAuthors(context.Background(), tc.in.in)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_UpdateNewsAuthors(t *testing.T) {
+	type inputParam struct {
+		in []presentation.UpdateNewsAuthorsRequest
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		in         inputParam
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					updateBulkNewsAuthors: updateBulkNewsAuthors{
+						err: fmt.Errorf("ASD"),
+					},
+				},
+			},
+			in: inputParam{
+				in: []presentation.UpdateNewsAuthorsRequest{
+					{
+						ID:   1,
+						Name: "A",
+					},
+					{
+						ID:   2,
+						Name: "B",
+					},
+					{
+						ID:   3,
+						Name: "C",
+					}, {
+						ID:   4,
+						Name: "D",
+					},
+				},
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Repo return no error, all updated",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					updateBulkNewsAuthors: updateBulkNewsAuthors{
+						updatedID: []int{1, 2, 3, 4},
+					},
+				},
+			},
+			in: inputParam{
+				in: []presentation.UpdateNewsAuthorsRequest{
+					{
+						ID:   1,
+						Name: "A",
+					},
+					{
+						ID:   2,
+						Name: "B",
+					},
+					{
+						ID:   3,
+						Name: "C",
+					}, {
+						ID:   4,
+						Name: "D",
+					},
+				},
+			},
+			mustReturn: []int{1, 2, 3, 4},
+			mustErr:    false,
+		},
+		{
+			name: "Success - Repo return no error, partially updated",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					updateBulkNewsAuthors: updateBulkNewsAuthors{
+						updatedID: []int{1, 2},
+					},
+				},
+			},
+			in: inputParam{
+				in: []presentation.UpdateNewsAuthorsRequest{
+					{
+						ID:   1,
+						Name: "A",
+					},
+					{
+						ID:   2,
+						Name: "B",
+					},
+					{
+						ID:   3,
+						Name: "C",
+					}, {
+						ID:   4,
+						Name: "D",
+					},
+				},
+			},
+			mustReturn: []int{1, 2},
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			got, err := uc.UpdateNewsAuthors(context.Background(), tc.in.in)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetNewsAuthors(t *testing.T) {
+	type inputParam struct {
+		paginationString string
+		filterString     string
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		in         inputParam
+		mustErr    bool
+		mustReturn []presentation.GetNewsAuthorsResponse
+		mustMeta   *presentation.PaginationMeta
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						err: fmt.Errorf("ASD"),
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("X")}, saveObject: saveObject{err: nil}},
+			},
+			in:         inputParam{},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - No Pagination, No Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{},
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{
+					ID:   1,
+					Name: "A",
+				},
+				{
+					ID:   2,
+					Name: "B",
+				},
+				{
+					ID:   3,
+					Name: "C",
+				},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success - With Pagination, No Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						total: 10,
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{
+				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+			},
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{
+					ID:   1,
+					Name: "A",
+				},
+				{
+					ID:   2,
+					Name: "B",
+				},
+				{
+					ID:   3,
+					Name: "C",
+				},
+			},
+			mustMeta: &presentation.PaginationMeta{Total: 10, Offset: 1, Count: 3, HasMore: true},
+			mustErr:  false,
+		},
+		{
+			name: "Success - No Pagination, With Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{
+				filterString: "eyJuYW1lIjoiQSJ9",
+			},
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{
+					ID:   1,
+					Name: "A",
+				},
+				{
+					ID:   2,
+					Name: "B",
+				},
+				{
+					ID:   3,
+					Name: "C",
+				},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success - With Pagination, With Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{
+				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+				filterString:     "eyJuYW1lIjoiQSJ9",
+			},
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{
+					ID:   1,
+					Name: "A",
+				},
+				{
+					ID:   2,
+					Name: "B",
+				},
+				{
+					ID:   3,
+					Name: "C",
+				},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Failed - Not Recognized Pagination, Not Recognized Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{
+				paginationString: "eyJvZ12312mZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+				filterString:     "eyJuYW1lI321231joiQSJ9",
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Failed - Not Recognized Pagination, With Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{
+				paginationString: "eyJvZ12312mZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+				filterString:     "eyJuYW1lIjoiQSJ9",
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Failed - With Pagination, Not Recognized Filter",
+			repository: &Repositories{
+				NewsAuthorDataRepository: &MockNewsAuthorDataRepository{
+					getBulkNewsAuthors: getBulkNewsAuthors{
+						res: []presentation.GetNewsAuthorsResponse{
+							{
+								ID:   1,
+								Name: "A",
+							},
+							{
+								ID:   2,
+								Name: "B",
+							},
+							{
+								ID:   3,
+								Name: "C",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+			},
+
+			in: inputParam{
+				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+				filterString:     "eyJuYW1lIwdafafda213joiQSJ9",
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			got, err := uc.GetNewsAuthors(context.Background(), tc.in.paginationString, tc.in.filterString, "")
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got.Data, tc.mustReturn) || (tc.mustMeta != nil && !reflect.DeepEqual(got.Meta, *tc.mustMeta)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -1,193 +1,373 @@
 package usecase
 
 import (
-	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
+	"time"
 )
 
-func (uc *Usecase) CreateSingleNews(newNews presentation.CreateNewsRequest) error {
-	_, err := uc.repositories.CreateBulkNews([]presentation.CreateNewsRequest{newNews})
+func (uc *Usecase) CreateSingleNews(ctx context.Context, newNews presentation.CreateNewsRequest) error {
+	if newNews.Status != 0 && newNews.Status != presentation.NEWS_STATUS_DRAFT {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: "CreateSingleNews",
+			Description:  "news is created as draft, status is changed through workflow transitions",
+			Trace:        ErrIllegalTransition,
+		}.Error()
+	}
+	newNews.Status = presentation.NEWS_STATUS_DRAFT
+
+	err := presentation.ValidateNewsSchedule(newNews.PublishAt, newNews.UnpublishAt)
+	if err != nil {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: "CreateSingleNews",
+			Description:  "Invalid schedule",
+			Trace:        err,
+		}.Error()
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		newNews.CreatedBy = principal.Subject
+	}
+
+	err = uc.repositories.WithTx(ctx, func(tx TxRepositories) error {
+		insertedID, err := tx.CreateBulkNews(ctx, []presentation.CreateNewsRequest{newNews})
+		if err != nil {
+			return err
+		}
+
+		if len(insertedID) <= 0 {
+			return response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "CreateSingleNews",
+				Description:  "No news created",
+				Trace:        nil,
+			}.Error()
+		}
+
+		if len(newNews.Topics) > 0 {
+			err = tx.CreateBulkNewsTopicsAssoc(ctx, []presentation.CreateNewsTopicsAssoc{{NewsID: insertedID[0], NewsTopicsID: newNews.Topics}})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(newNews.Tags) > 0 {
+			err = tx.CreateBulkNewsTagsAssoc(ctx, []presentation.CreateNewsTagsAssoc{{NewsID: insertedID[0], NewsTagID: newNews.Tags}})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(newNews.Authors) > 0 {
+			err = tx.CreateBulkNewsAuthorsAssoc(ctx, []presentation.CreateNewsAuthorsAssoc{{NewsID: insertedID[0], NewsAuthorID: newNews.Authors}})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.FlushAll()
+	uc.invalidateCache(ctx, "CreateSingleNews", CACHE_TAG_NEWS_LIST)
+
+	return nil
+}
+
+func (uc *Usecase) UpdateSingleNews(ctx context.Context, updatedNews presentation.UpdateNewsRequest) error {
+	err := presentation.ValidateNewsSchedule(updatedNews.PublishAt, updatedNews.UnpublishAt)
 	if err != nil {
-		logger.Error(response.InternalError{
+		return response.InternalError{
 			Type:         "UC",
 			Name:         "News Data",
-			FunctionName: "CreateSingleNews",
-			Description:  "Failed Flush data redis",
+			FunctionName: "UpdateSingleNews",
+			Description:  "Invalid schedule",
 			Trace:        err,
-		}.Error())
+		}.Error()
+	}
+
+	err = uc.authorizeNewsChange(ctx, "UpdateSingleNews", updatedNews.ID)
+	if err != nil {
+		return err
+	}
+
+	err = uc.checkStatusUnchanged(ctx, updatedNews.ID, updatedNews.Status)
+	if err != nil {
+		return err
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		updatedNews.UpdatedBy = principal.Subject
+	}
+
+	updatedID, err := uc.repositories.UpdateBulkNews(ctx, []presentation.UpdateNewsRequest{updatedNews})
+	if err != nil {
+		return err
+	}
+
+	// Deleted news is not updated either
+	if len(updatedID) <= 0 {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: "UpdateSingleNews",
+			Description:  "Data Not Found or deleted",
+			Trace:        ErrNewsNotFound,
+		}.Error()
 	}
 
+	uc.invalidateCache(ctx, "UpdateSingleNews", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, updatedNews.ID))
+
 	return nil
 }
 
-func (uc *Usecase) UpdateSingleNews(updatedNews presentation.UpdateNewsRequest) error {
-	_, err := uc.repositories.UpdateBulkNews([]presentation.UpdateNewsRequest{updatedNews})
+// DeleteSingleNews soft delete the news, topic and tag associations are kept so RestoreSingleNews bring it back intact
+func (uc *Usecase) DeleteSingleNews(ctx context.Context, newsId int) error {
+	deletedID, err := uc.repositories.DeleteBulkNews(ctx, []int{newsId})
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.FlushAll()
+	if len(deletedID) <= 0 {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: "DeleteSingleNews",
+			Description:  "Data Not Found or already deleted",
+			Trace:        newsId,
+		}.Error()
+	}
+
+	uc.invalidateCache(ctx, "DeleteSingleNews", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, newsId))
+
+	return nil
+}
+
+func (uc *Usecase) RestoreSingleNews(ctx context.Context, newsId int) error {
+	restoredID, err := uc.repositories.RestoreBulkNews(ctx, []int{newsId})
 	if err != nil {
-		logger.Error(response.InternalError{
+		return err
+	}
+
+	if len(restoredID) <= 0 {
+		return response.InternalError{
 			Type:         "UC",
 			Name:         "News Data",
-			FunctionName: "CreateSingleNews",
-			Description:  "Failed Flush data redis",
-			Trace:        err,
-		}.Error())
+			FunctionName: "RestoreSingleNews",
+			Description:  "Data Not Found or not deleted",
+			Trace:        newsId,
+		}.Error()
 	}
 
+	uc.invalidateCache(ctx, "RestoreSingleNews", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, newsId))
+
 	return nil
 }
 
-func (uc *Usecase) DeleteSingleNews(newsId int) error {
-	_, err := uc.repositories.DeleteBulkNews([]int{newsId})
+// PurgeDeletedNews hard delete news which have been soft deleted for longer than retention
+func (uc *Usecase) PurgeDeletedNews(ctx context.Context, retention time.Duration) (purgedID []int, err error) {
+	purgedID, err = uc.repositories.PurgeDeletedNews(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(purgedID) <= 0 {
+		return purgedID, nil
+	}
+
+	uc.invalidateCache(ctx, "PurgeDeletedNews", append(idCacheTags(CACHE_TAG_NEWS, purgedID), CACHE_TAG_NEWS_LIST)...)
+
+	return purgedID, nil
+}
+
+func (uc *Usecase) AssignNewsWithNewsTopic(ctx context.Context, in presentation.CreateNewsTopicsAssoc) error {
+	err := uc.authorizeNewsChange(ctx, "AssignNewsWithNewsTopic", in.NewsID)
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.CleanNewsTopicsAssoc([]int{newsId})
+	err = uc.repositories.CreateBulkNewsTopicsAssoc(ctx, []presentation.CreateNewsTopicsAssoc{in})
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.CleanNewsTagAssoc([]int{newsId})
+	uc.invalidateCache(ctx, "AssignNewsWithNewsTopic", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, in.NewsID))
+	return nil
+}
+
+func (uc *Usecase) AssignNewsWithNewsTag(ctx context.Context, in presentation.CreateNewsTagsAssoc) error {
+	err := uc.authorizeNewsChange(ctx, "AssignNewsWithNewsTag", in.NewsID)
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.FlushAll()
+	err = uc.repositories.CreateBulkNewsTagsAssoc(ctx, []presentation.CreateNewsTagsAssoc{in})
 	if err != nil {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "CreateSingleNews",
-			Description:  "Failed Flush data redis",
-			Trace:        err,
-		}.Error())
+		return err
 	}
 
+	uc.invalidateCache(ctx, "AssignNewsWithNewsTag", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, in.NewsID))
 	return nil
 }
 
-func (uc *Usecase) AssignNewsWithNewsTopic(in presentation.CreateNewsTopicsAssoc) error {
-	err := uc.repositories.CreateBulkNewsTopicsAssoc([]presentation.CreateNewsTopicsAssoc{in})
+// ReassignNewsTopics replace every topic of the news with in.NewsTopicsID
+func (uc *Usecase) ReassignNewsTopics(ctx context.Context, in presentation.CreateNewsTopicsAssoc) error {
+	err := uc.authorizeNewsChange(ctx, "ReassignNewsTopics", in.NewsID)
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.FlushAll()
+	err = uc.repositories.WithTx(ctx, func(tx TxRepositories) error {
+		err := tx.CleanNewsTopicsAssoc(ctx, []int{in.NewsID})
+		if err != nil {
+			return err
+		}
+
+		if len(in.NewsTopicsID) <= 0 {
+			return nil
+		}
+
+		return tx.CreateBulkNewsTopicsAssoc(ctx, []presentation.CreateNewsTopicsAssoc{in})
+	})
 	if err != nil {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "AssignNewsWithNewsTopic",
-			Description:  "Failed Flush data redis",
-			Trace:        err,
-		}.Error())
+		return err
 	}
+
+	uc.invalidateCache(ctx, "ReassignNewsTopics", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, in.NewsID))
 	return nil
 }
 
-func (uc *Usecase) AssignNewsWithNewsTag(in presentation.CreateNewsTagsAssoc) error {
-	err := uc.repositories.CreateBulkNewsTagsAssoc([]presentation.CreateNewsTagsAssoc{in})
+// ReassignNewsTags replace every tag of the news with in.NewsTagID
+func (uc *Usecase) ReassignNewsTags(ctx context.Context, in presentation.CreateNewsTagsAssoc) error {
+	err := uc.authorizeNewsChange(ctx, "ReassignNewsTags", in.NewsID)
 	if err != nil {
 		return err
 	}
 
-	err = uc.repositories.FlushAll()
+	err = uc.repositories.WithTx(ctx, func(tx TxRepositories) error {
+		err := tx.CleanNewsTagAssoc(ctx, []int{in.NewsID})
+		if err != nil {
+			return err
+		}
+
+		if len(in.NewsTagID) <= 0 {
+			return nil
+		}
+
+		return tx.CreateBulkNewsTagsAssoc(ctx, []presentation.CreateNewsTagsAssoc{in})
+	})
 	if err != nil {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "CreateSingleNews",
-			Description:  "Failed Flush data redis",
-			Trace:        err,
-		}.Error())
+		return err
 	}
+
+	uc.invalidateCache(ctx, "ReassignNewsTags", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, in.NewsID))
 	return nil
 }
 
-func (uc *Usecase) GetSingleNews(ctx *gin.Context, newsId int) (presentation.GetNewsResponse, error) {
-	// Get From Redis First
-	var redisData presentation.GetNewsResponse
-	err := uc.repositories.GetObject(ctx.Request.RequestURI, &redisData)
-	if err == nil {
-		return redisData, nil
+func (uc *Usecase) AssignNewsWithNewsAuthor(ctx context.Context, in presentation.CreateNewsAuthorsAssoc) error {
+	err := uc.authorizeNewsChange(ctx, "AssignNewsWithNewsAuthor", in.NewsID)
+	if err != nil {
+		return err
 	}
 
-	// Get From Database
-	news, err := uc.repositories.GetBulkNews(presentation.Pagination{
-		Offset: 0,
-		Count:  1,
-	}, &presentation.NewsFilter{NewsID: newsId})
+	err = uc.repositories.CreateBulkNewsAuthorsAssoc(ctx, []presentation.CreateNewsAuthorsAssoc{in})
 	if err != nil {
-		return presentation.GetNewsResponse{}, response.InternalError{
-			Type:         "Usecase",
-			Name:         "News Data",
-			FunctionName: "GetSingleNews",
-			Description:  "Failed running repository",
-			Trace:        err,
-		}.Error()
+		return err
 	}
 
-	// Save Result to Redis
-	if len(news) <= 0 {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetSingleNews",
-			Description:  "Data Not Found",
-			Trace:        err,
-		}.Error())
+	uc.invalidateCache(ctx, "AssignNewsWithNewsAuthor", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, in.NewsID))
+	return nil
+}
 
-		return presentation.GetNewsResponse{}, response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetSingleNews",
-			Description:  "Data Not Found",
-			Trace:        err,
-		}.Error()
+// ReassignNewsAuthors replace the news byline with in.NewsAuthorID, in the given order
+func (uc *Usecase) ReassignNewsAuthors(ctx context.Context, in presentation.CreateNewsAuthorsAssoc) error {
+	err := uc.authorizeNewsChange(ctx, "ReassignNewsAuthors", in.NewsID)
+	if err != nil {
+		return err
+	}
 
+	err = uc.repositories.WithTx(ctx, func(tx TxRepositories) error {
+		err := tx.CleanNewsAuthorsAssoc(ctx, []int{in.NewsID})
+		if err != nil {
+			return err
+		}
+
+		if len(in.NewsAuthorID) <= 0 {
+			return nil
+		}
+
+		return tx.CreateBulkNewsAuthorsAssoc(ctx, []presentation.CreateNewsAuthorsAssoc{in})
+	})
+	if err != nil {
+		return err
 	}
 
-	err = uc.repositories.SaveObject(ctx.Request.RequestURI, news[0])
+	uc.invalidateCache(ctx, "ReassignNewsAuthors", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, in.NewsID))
+	return nil
+}
+
+func (uc *Usecase) GetSingleNews(ctx context.Context, newsId int) (presentation.GetNewsResponse, error) {
+	// Live is not part of the filter JSON, public and staff read are cached apart by the key
+	live := liveOnly(ctx)
+	cacheKey, err := buildCacheKey(CACHE_NAMESPACE_SINGLE_NEWS, newsId, live)
 	if err != nil {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetSingleNews",
-			Description:  "Failed Store data to redis",
-			Trace:        err,
-		}.Error())
+		return presentation.GetNewsResponse{}, err
+	}
+
+	// Get From Redis First, then from Database
+	var res presentation.GetNewsResponse
+	err = uc.cachedRead(ctx, "GetSingleNews", cacheKey, &res, func(ctx context.Context) (interface{}, []string, error) {
+		news, _, err := uc.repositories.GetBulkNews(ctx, presentation.Pagination{
+			Offset: 0,
+			Count:  1,
+		}, &presentation.NewsFilter{NewsID: newsId, Live: live}, "")
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "Usecase",
+				Name:         "News Data",
+				FunctionName: "GetSingleNews",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+
+		if len(news) <= 0 {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "GetSingleNews",
+				Description:  "Data Not Found",
+				Trace:        ErrNewsNotFound,
+			}.Error()
+		}
+
+		return news[0], newsCacheTags(news[:1], true), nil
+	})
+	if err != nil {
+		return presentation.GetNewsResponse{}, err
 	}
-	return news[0], nil
 
+	return uc.applyResponseOptions([]presentation.GetNewsResponse{res})[0], nil
 }
 
-func (uc *Usecase) GetNews(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsResponse, err error) {
+func (uc *Usecase) GetNews(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsListResponse, err error) {
 	var pagination presentation.Pagination
 	var newsFilter *presentation.NewsFilter
 
-	// Get From Redis First
-	var redisData []presentation.GetNewsResponse
-	err = uc.repositories.GetObject(ctx.Request.RequestURI, &redisData)
-	if err == nil {
-		return redisData, nil
-	}
-
-	// Get From Database
 	err = urlutils.DecodeEncodedString(paginationString, &pagination)
 	if err != nil {
-		return nil, response.InternalError{
+		return res, response.InternalError{
 			Type:         "Usecase",
 			Name:         "News Data",
 			FunctionName: "GetNews",
@@ -199,38 +379,273 @@ func (uc *Usecase) GetNews(ctx *gin.Context, paginationString, filterString stri
 	if filterString != "" {
 		err = urlutils.DecodeEncodedString(filterString, &newsFilter)
 		if err != nil {
-			return nil, response.InternalError{
+			return res, response.InternalError{
 				Type:         "Usecase",
 				Name:         "News Data",
 				FunctionName: "GetNews",
 				Description:  "Failed running repository",
+				Trace:        fmt.Errorf("%w, %v", ErrInvalidFilter, err),
+			}.Error()
+		}
+
+		err = newsFilter.Validate()
+		if err != nil {
+			return res, response.InternalError{
+				Type:         "Usecase",
+				Name:         "News Data",
+				FunctionName: "GetNews",
+				Description:  "Invalid filter",
+				Trace:        fmt.Errorf("%w, %v", ErrInvalidFilter, err),
+			}.Error()
+		}
+
+		normalizeNewsFilter(newsFilter)
+	}
+
+	live := liveOnly(ctx)
+	newsFilter = withLiveOnly(newsFilter, live)
+
+	cacheKey, err := buildCacheKey(CACHE_NAMESPACE_NEWS, pagination, newsFilter, sortString, live)
+	if err != nil {
+		return res, err
+	}
+
+	if pagination.Cursor != "" {
+		pagination.Position, err = decodeNewsCursor(pagination.Cursor, sortString)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	// Get From Redis First, then from Database
+	err = uc.cachedRead(ctx, "GetNews", cacheKey, &res, func(ctx context.Context) (interface{}, []string, error) {
+		news, total, err := uc.repositories.GetBulkNews(ctx, pagination, newsFilter, sortString)
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "GetNews",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+
+		loaded := presentation.GetNewsListResponse{
+			Data: news,
+			Meta: presentation.NewPaginationMeta(&pagination, len(news), total),
+		}
+
+		err = setNewsCursors(&loaded.Meta, pagination.Position, sortString, news)
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "GetNews",
+				Description:  "Failed encode cursor",
 				Trace:        err,
 			}.Error()
 		}
+
+		// Cached together with pagination metadata
+		return loaded, append(newsCacheTags(news, false), CACHE_TAG_NEWS_LIST), nil
+	})
+	if err != nil {
+		return res, err
 	}
 
-	res, err = uc.repositories.GetBulkNews(pagination, newsFilter)
+	res.Data = uc.applyResponseOptions(res.Data)
+	return res, nil
+}
+
+// SearchNews run full-text search over news title and content, filterString take the same NewsFilter as GetNews
+func (uc *Usecase) SearchNews(ctx context.Context, searchQuery, paginationString, filterString string) (res presentation.SearchNewsListResponse, err error) {
+	var pagination presentation.Pagination
+	var newsFilter *presentation.NewsFilter
+
+	err = urlutils.DecodeEncodedString(paginationString, &pagination)
 	if err != nil {
 		return res, response.InternalError{
+			Type:         "Usecase",
+			Name:         "News Data",
+			FunctionName: "SearchNews",
+			Description:  "Failed decode pagination",
+			Trace:        err,
+		}.Error()
+	}
+
+	// Search is ranked by relevance, there is no keyset to page by
+	if pagination.Cursor != "" {
+		return res, response.InternalError{
+			Type:         "UC",
+			Name:         "News Data",
+			FunctionName: "SearchNews",
+			Description:  "cursor pagination is not supported on search",
+			Trace:        ErrInvalidCursor,
+		}.Error()
+	}
+
+	if filterString != "" {
+		err = urlutils.DecodeEncodedString(filterString, &newsFilter)
+		if err != nil {
+			return res, response.InternalError{
+				Type:         "Usecase",
+				Name:         "News Data",
+				FunctionName: "SearchNews",
+				Description:  "Failed decode filter",
+				Trace:        fmt.Errorf("%w, %v", ErrInvalidFilter, err),
+			}.Error()
+		}
+
+		err = newsFilter.Validate()
+		if err != nil {
+			return res, response.InternalError{
+				Type:         "Usecase",
+				Name:         "News Data",
+				FunctionName: "SearchNews",
+				Description:  "Invalid filter",
+				Trace:        fmt.Errorf("%w, %v", ErrInvalidFilter, err),
+			}.Error()
+		}
+
+		normalizeNewsFilter(newsFilter)
+	}
+
+	searchQuery = normalizeSearchQuery(searchQuery)
+
+	live := liveOnly(ctx)
+	newsFilter = withLiveOnly(newsFilter, live)
+
+	cacheKey, err := buildCacheKey(CACHE_NAMESPACE_NEWS_SEARCH, searchQuery, pagination, newsFilter, live)
+	if err != nil {
+		return res, err
+	}
+
+	// Get From Redis First, then from Database
+	err = uc.cachedRead(ctx, "SearchNews", cacheKey, &res, func(ctx context.Context) (interface{}, []string, error) {
+		news, total, err := uc.repositories.SearchNews(ctx, searchQuery, pagination, newsFilter)
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "SearchNews",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+
+		found := make([]presentation.GetNewsResponse, 0, len(news))
+		for _, n := range news {
+			found = append(found, n.GetNewsResponse)
+		}
+
+		// Cached together with pagination metadata
+		return presentation.SearchNewsListResponse{
+			Data: news,
+			Meta: presentation.NewPaginationMeta(&pagination, len(news), total),
+		}, append(newsCacheTags(found, false), CACHE_TAG_NEWS_LIST), nil
+	})
+	if err != nil {
+		return res, err
+	}
+
+	res.Data = uc.applySearchResponseOptions(res.Data)
+	return res, nil
+}
+
+// applyResponseOptions clear legacy TopicsName and TagsName unless Options.LegacyAssocNames is set.
+// news may be shared by coalesced reads, so it is copied instead of changed in place
+func (uc *Usecase) applyResponseOptions(news []presentation.GetNewsResponse) []presentation.GetNewsResponse {
+	if uc.options.LegacyAssocNames || news == nil {
+		return news
+	}
+
+	res := make([]presentation.GetNewsResponse, len(news))
+	copy(res, news)
+	for i := range res {
+		res[i].TopicsName = ""
+		res[i].TagsName = ""
+	}
+
+	return res
+}
+
+// applySearchResponseOptions is applyResponseOptions for search result
+func (uc *Usecase) applySearchResponseOptions(news []presentation.SearchNewsResponse) []presentation.SearchNewsResponse {
+	if uc.options.LegacyAssocNames || news == nil {
+		return news
+	}
+
+	res := make([]presentation.SearchNewsResponse, len(news))
+	copy(res, news)
+	for i := range res {
+		res[i].TopicsName = ""
+		res[i].TagsName = ""
+	}
+
+	return res
+}
+
+// decodeNewsCursor decode and validate cursor of GetNews, keyset follow fixed (created_at, id) order so sort must be empty
+func decodeNewsCursor(cursorString, sortString string) (*presentation.NewsCursor, error) {
+	if sortString != "" {
+		return nil, response.InternalError{
 			Type:         "UC",
 			Name:         "News Data",
 			FunctionName: "GetNews",
-			Description:  "Failed Store data to redis",
-			Trace:        err,
+			Description:  "sort is not supported on cursor pagination",
+			Trace:        ErrInvalidCursor,
 		}.Error()
 	}
 
-	// Save Result to Redis
-	err = uc.repositories.SaveObject(ctx.Request.RequestURI, res)
+	var cursor presentation.NewsCursor
+	err := urlutils.DecodeEncodedString(cursorString, &cursor)
+	if err == nil {
+		err = cursor.Validate()
+	}
+
 	if err != nil {
-		logger.Error(response.InternalError{
+		return nil, response.InternalError{
 			Type:         "UC",
 			Name:         "News Data",
 			FunctionName: "GetNews",
-			Description:  "Failed Store data to redis",
-			Trace:        err,
-		}.Error())
+			Description:  "Failed decode cursor",
+			Trace:        fmt.Errorf("%w, %v", ErrInvalidCursor, err),
+		}.Error()
 	}
 
-	return res, nil
+	return &cursor, nil
+}
+
+// setNewsCursors fill next_cursor and prev_cursor from last and first news of the page.
+// On cursor pagination HasMore tell whether more news exist in the cursor direction, the opposite direction always has the page we came from.
+// Cursor follow the default (created_at, id) order, page sorted by other column get no cursor
+func setNewsCursors(meta *presentation.PaginationMeta, cursor *presentation.NewsCursor, sortString string, news []presentation.GetNewsResponse) (err error) {
+	if len(news) == 0 || sortString != "" {
+		return nil
+	}
+
+	hasNext, hasPrev := meta.HasMore, meta.Offset > 0
+	if cursor != nil && cursor.Direction == presentation.CURSOR_DIRECTION_PREV {
+		hasNext, hasPrev = true, meta.HasMore
+	} else if cursor != nil {
+		hasPrev = true
+	}
+
+	if hasNext {
+		last := news[len(news)-1]
+		meta.NextCursor, err = urlutils.EncodeStruct(presentation.NewsCursor{CreatedAt: last.CreatedAt, ID: last.ID, Direction: presentation.CURSOR_DIRECTION_NEXT})
+		if err != nil {
+			return err
+		}
+	}
+
+	if hasPrev {
+		first := news[0]
+		meta.PrevCursor, err = urlutils.EncodeStruct(presentation.NewsCursor{CreatedAt: first.CreatedAt, ID: first.ID, Direction: presentation.CURSOR_DIRECTION_PREV})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -1,12 +1,22 @@
 package usecase
 
-import "github.com/Mufidzz/bareksa-test/presentation"
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"time"
+)
 
 type MockNewsRepository struct {
 	createBulkNews createBulkNews
 	getBulkNews    getBulkNews
+	searchNews     searchNews
 	updateBulkNews updateBulkNews
 	deleteBulkNews deleteBulkNews
+
+	restoreBulkNews  restoreBulkNews
+	purgeDeletedNews purgeDeletedNews
 }
 
 type createBulkNews struct {
@@ -15,8 +25,18 @@ type createBulkNews struct {
 }
 
 type getBulkNews struct {
-	res []presentation.GetNewsResponse
-	err error
+	res   []presentation.GetNewsResponse
+	total int64
+	err   error
+
+	// mustPrimaryRead fail the call when ctx is not routed to primary database
+	mustPrimaryRead bool
+}
+
+type searchNews struct {
+	res   []presentation.SearchNewsResponse
+	total int64
+	err   error
 }
 
 type updateBulkNews struct {
@@ -29,18 +49,44 @@ type deleteBulkNews struct {
 	err       error
 }
 
-func (mnr *MockNewsRepository) CreateBulkNews(in []presentation.CreateNewsRequest) (insertedID []int, err error) {
+type restoreBulkNews struct {
+	restoredID []int
+	err        error
+}
+
+type purgeDeletedNews struct {
+	purgedID []int
+	err      error
+}
+
+func (mnr *MockNewsRepository) CreateBulkNews(ctx context.Context, in []presentation.CreateNewsRequest) (insertedID []int, err error) {
 	return mnr.createBulkNews.insertedID, mnr.createBulkNews.err
 }
 
-func (mnr *MockNewsRepository) GetBulkNews(pagination presentation.Pagination, filter *presentation.NewsFilter) (res []presentation.GetNewsResponse, err error) {
-	return mnr.getBulkNews.res, mnr.getBulkNews.err
+func (mnr *MockNewsRepository) GetBulkNews(ctx context.Context, pagination presentation.Pagination, filter *presentation.NewsFilter, sort string) (res []presentation.GetNewsResponse, total int64, err error) {
+	if mnr.getBulkNews.mustPrimaryRead && !dbutils.PrimaryRead(ctx) {
+		return nil, 0, fmt.Errorf("read is not routed to primary")
+	}
+
+	return mnr.getBulkNews.res, mnr.getBulkNews.total, mnr.getBulkNews.err
+}
+
+func (mnr *MockNewsRepository) SearchNews(ctx context.Context, query string, pagination presentation.Pagination, filter *presentation.NewsFilter) (res []presentation.SearchNewsResponse, total int64, err error) {
+	return mnr.searchNews.res, mnr.searchNews.total, mnr.searchNews.err
 }
 
-func (mnr *MockNewsRepository) UpdateBulkNews(in []presentation.UpdateNewsRequest) (updatedID []int, err error) {
+func (mnr *MockNewsRepository) UpdateBulkNews(ctx context.Context, in []presentation.UpdateNewsRequest) (updatedID []int, err error) {
 	return mnr.updateBulkNews.updatedID, mnr.updateBulkNews.err
 }
 
-func (mnr *MockNewsRepository) DeleteBulkNews(newsID []int) (deletedID []int, err error) {
+func (mnr *MockNewsRepository) DeleteBulkNews(ctx context.Context, newsID []int) (deletedID []int, err error) {
 	return mnr.deleteBulkNews.deletedID, mnr.deleteBulkNews.err
 }
+
+func (mnr *MockNewsRepository) RestoreBulkNews(ctx context.Context, newsID []int) (restoredID []int, err error) {
+	return mnr.restoreBulkNews.restoredID, mnr.restoreBulkNews.err
+}
+
+func (mnr *MockNewsRepository) PurgeDeletedNews(ctx context.Context, deletedBefore time.Time) (purgedID []int, err error) {
+	return mnr.purgeDeletedNews.purgedID, mnr.purgeDeletedNews.err
+}
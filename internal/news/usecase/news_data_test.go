@@ -1,12 +1,13 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
-	"log"
-	"net/http"
 	"reflect"
 	"testing"
 	"time"
@@ -17,43 +18,132 @@ func Test_CreateSingleNews(t *testing.T) {
 		newNews presentation.CreateNewsRequest
 	}
 
+	today := time.Now()
+	tomorrow := today.Add(24 * time.Hour)
+
 	testcases := []struct {
-		name       string
-		repository *Repositories
-		in         inputParam
-		mustErr    bool
+		name           string
+		transaction    *MockTransactionRepository
+		in             inputParam
+		mustErr        bool
+		mustRolledBack bool
 	}{
+		{
+			name:        "Failed - Created Other Than Draft",
+			transaction: &MockTransactionRepository{beginErr: fmt.Errorf("transaction must not be begun")},
+			in: inputParam{newNews: presentation.CreateNewsRequest{
+				Title:   "A",
+				Content: "B",
+				Status:  presentation.NEWS_STATUS_PUBLISHED,
+			}},
+			mustErr: true,
+		},
+		{
+			name:        "Failed - Unpublish Before Publish",
+			transaction: &MockTransactionRepository{beginErr: fmt.Errorf("transaction must not be begun")},
+			in: inputParam{newNews: presentation.CreateNewsRequest{
+				Title:       "A",
+				Content:     "B",
+				PublishAt:   &tomorrow,
+				UnpublishAt: &today,
+			}},
+			mustErr: true,
+		},
+		{
+			name:        "Failed - Begin Transaction Error",
+			transaction: &MockTransactionRepository{beginErr: fmt.Errorf("AXDCZ")},
+			in: inputParam{newNews: presentation.CreateNewsRequest{
+				Title:   "A",
+				Content: "B",
+				Status:  1,
+			}},
+			mustErr: true,
+		},
 		{
 			name: "Failed - Repo return error",
-			repository: &Repositories{
+			transaction: &MockTransactionRepository{tx: &Repositories{
 				NewsDataRepository: &MockNewsRepository{
 					createBulkNews: createBulkNews{err: fmt.Errorf("AXDCZ")},
 				},
-			},
+			}},
 			in: inputParam{newNews: presentation.CreateNewsRequest{
 				Title:   "A",
 				Content: "B",
 				Status:  1,
 			}},
-			mustErr: true,
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Failed - Assign Topics return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					createBulkNews: createBulkNews{insertedID: []int{123}},
+				},
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsTopicsAssoc: createBulkNewsTopicsAssoc{err: fmt.Errorf("AXDCZ")},
+				},
+			}},
+			in: inputParam{newNews: presentation.CreateNewsRequest{
+				Title:   "A",
+				Content: "B",
+				Status:  1,
+				Topics:  []int{1, 2},
+			}},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Failed - Assign Authors return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					createBulkNews: createBulkNews{insertedID: []int{123}},
+				},
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsAuthorsAssoc: createBulkNewsAuthorsAssoc{err: fmt.Errorf("AXDCZ")},
+				},
+			}},
+			in: inputParam{newNews: presentation.CreateNewsRequest{
+				Title:   "A",
+				Content: "B",
+				Status:  1,
+				Authors: []int{4, 5},
+			}},
+			mustErr:        true,
+			mustRolledBack: true,
 		},
 		{
 			name: "Success - Repo return no error",
-			repository: &Repositories{
+			transaction: &MockTransactionRepository{tx: &Repositories{
 				NewsDataRepository: &MockNewsRepository{
 					createBulkNews: createBulkNews{
 						insertedID: []int{123},
 						err:        nil,
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{flushAll: flushAll{
-					nil,
-				}},
-			},
+			}},
+			in: inputParam{newNews: presentation.CreateNewsRequest{
+				Title:   "A",
+				Content: "B",
+				Status:  1,
+			}},
+			mustErr: false,
+		},
+		{
+			name: "Success - With Topics, Tags and Authors",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					createBulkNews: createBulkNews{insertedID: []int{123}},
+				},
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{},
+			}},
 			in: inputParam{newNews: presentation.CreateNewsRequest{
 				Title:   "A",
 				Content: "B",
 				Status:  1,
+				Topics:  []int{1, 2},
+				Tags:    []int{3},
+				Authors: []int{4, 5},
 			}},
 			mustErr: false,
 		},
@@ -62,17 +152,20 @@ func Test_CreateSingleNews(t *testing.T) {
 	for _, tc := range testcases {
 		t.Run(tc.name, func(tt *testing.T) {
 			uc := Usecase{
-				repositories: tc.repository,
+				repositories: &Repositories{
+					TransactionRepository: tc.transaction,
+					NewsRedisRepository:   &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+				},
 			}
 
-			err := uc.CreateSingleNews(tc.in.newNews)
+			err := uc.CreateSingleNews(context.Background(), tc.in.newNews)
 
-			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || tc.mustRolledBack != tc.transaction.rolledBack {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
 					FunctionName: "Test_CreateSingleNews",
 					Description:  "Testcase run unsuccessfully",
-					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+					Trace:        fmt.Sprintf("mustErr %v, err %v, mustRolledBack %v, rolledBack %v", tc.mustErr, err, tc.mustRolledBack, tc.transaction.rolledBack),
 				}.Error())
 			}
 		})
@@ -84,11 +177,15 @@ func Test_UpdateSingleNews(t *testing.T) {
 		updatedNews presentation.UpdateNewsRequest
 	}
 
+	owner := "writer-1"
+
 	testcases := []struct {
 		name       string
 		repository *Repositories
+		principal  *auth.Principal
 		in         inputParam
 		mustErr    bool
+		mustErrIs  error
 	}{
 		{
 			name: "Failed - Repo return error",
@@ -105,6 +202,23 @@ func Test_UpdateSingleNews(t *testing.T) {
 			}},
 			mustErr: true,
 		},
+		{
+			name: "Failed - News Not Found Or Deleted",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					updateBulkNews: updateBulkNews{updatedID: nil},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+			},
+			in: inputParam{updatedNews: presentation.UpdateNewsRequest{
+				ID:      1,
+				Title:   "A",
+				Content: "B",
+				Status:  1,
+			}},
+			mustErr:   true,
+			mustErrIs: ErrNewsNotFound,
+		},
 		{
 			name: "Success - Repo return no error",
 			repository: &Repositories{
@@ -114,7 +228,7 @@ func Test_UpdateSingleNews(t *testing.T) {
 						err:       nil,
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{flushAll: flushAll{nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
 			},
 			in: inputParam{updatedNews: presentation.UpdateNewsRequest{
 				ID:      1,
@@ -124,6 +238,83 @@ func Test_UpdateSingleNews(t *testing.T) {
 			}},
 			mustErr: false,
 		},
+		{
+			name: "Failed - Writer Change Other News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, CreatedBy: &owner}}},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+			},
+			principal: &auth.Principal{Subject: "writer-2", Role: auth.ROLE_WRITER},
+			in:        inputParam{updatedNews: presentation.UpdateNewsRequest{ID: 1, Title: "A"}},
+			mustErr:   true,
+			mustErrIs: auth.ErrForbidden,
+		},
+		{
+			name: "Failed - Writer Change News Without Owner",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1}}},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+			},
+			principal: &auth.Principal{Subject: "writer-1", Role: auth.ROLE_WRITER},
+			in:        inputParam{updatedNews: presentation.UpdateNewsRequest{ID: 1, Title: "A"}},
+			mustErr:   true,
+			mustErrIs: auth.ErrForbidden,
+		},
+		{
+			name: "Success - Writer Change Own News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, CreatedBy: &owner}}},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{},
+			},
+			principal: &auth.Principal{Subject: "writer-1", Role: auth.ROLE_WRITER},
+			in:        inputParam{updatedNews: presentation.UpdateNewsRequest{ID: 1, Title: "A"}},
+			mustErr:   false,
+		},
+		{
+			name: "Failed - Status Changed Outside Workflow",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, Status: presentation.NEWS_STATUS_DRAFT}}},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{},
+			},
+			in:        inputParam{updatedNews: presentation.UpdateNewsRequest{ID: 1, Title: "A", Status: presentation.NEWS_STATUS_PUBLISHED}},
+			mustErr:   true,
+			mustErrIs: ErrIllegalTransition,
+		},
+		{
+			name: "Success - Current Status Sent Back",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, Status: presentation.NEWS_STATUS_PUBLISHED}}, mustPrimaryRead: true},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{},
+			},
+			in:      inputParam{updatedNews: presentation.UpdateNewsRequest{ID: 1, Title: "A", Status: presentation.NEWS_STATUS_PUBLISHED}},
+			mustErr: false,
+		},
+		{
+			name: "Success - Editor Change Other News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{err: fmt.Errorf("owner must not be read")},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{},
+			},
+			principal: &auth.Principal{Subject: "editor-1", Role: auth.ROLE_EDITOR},
+			in:        inputParam{updatedNews: presentation.UpdateNewsRequest{ID: 1, Title: "A"}},
+			mustErr:   false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -132,9 +323,14 @@ func Test_UpdateSingleNews(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			err := uc.UpdateSingleNews(tc.in.updatedNews)
+			ctx := context.Background()
+			if tc.principal != nil {
+				ctx = auth.WithPrincipal(ctx, *tc.principal)
+			}
+
+			err := uc.UpdateSingleNews(ctx, tc.in.updatedNews)
 
-			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
 					FunctionName: "Test_UpdateSingleNews",
@@ -163,10 +359,18 @@ func Test_DeleteSingleNews(t *testing.T) {
 				NewsDataRepository: &MockNewsRepository{
 					deleteBulkNews: deleteBulkNews{err: fmt.Errorf("AXDCZ")},
 				},
-				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
-					cleanNewsTagsAssoc:   cleanNewsTagsAssoc{err: nil},
-					cleanNewsTopicsAssoc: cleanNewsTopicsAssoc{err: nil},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+			},
+			in:      inputParam{newsID: 123},
+			mustErr: true,
+		},
+		{
+			name: "Failed - News not found or already deleted",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					deleteBulkNews: deleteBulkNews{deletedID: nil},
 				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
 			},
 			in:      inputParam{newsID: 123},
 			mustErr: true,
@@ -180,26 +384,18 @@ func Test_DeleteSingleNews(t *testing.T) {
 						err:       nil,
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{flushAll: flushAll{
-					nil,
-				}},
-				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
-					cleanNewsTagsAssoc:   cleanNewsTagsAssoc{err: nil},
-					cleanNewsTopicsAssoc: cleanNewsTopicsAssoc{err: nil},
-				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
 			},
-			in:      inputParam{newsID: 124312},
+			in:      inputParam{newsID: 123},
 			mustErr: false,
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(tt *testing.T) {
-			uc := Usecase{
-				repositories: tc.repository,
-			}
+			uc := Usecase{repositories: tc.repository}
 
-			err := uc.DeleteSingleNews(tc.in.newsID)
+			err := uc.DeleteSingleNews(context.Background(), tc.in.newsID)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
@@ -213,102 +409,120 @@ func Test_DeleteSingleNews(t *testing.T) {
 	}
 }
 
-func Test_GetSingleNews(t *testing.T) {
+func Test_RestoreSingleNews(t *testing.T) {
 	type inputParam struct {
 		newsID int
 	}
 
-	now := time.Now()
-
 	testcases := []struct {
 		name       string
 		repository *Repositories
 		in         inputParam
-		mustReturn presentation.GetNewsResponse
 		mustErr    bool
 	}{
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
 				NewsDataRepository: &MockNewsRepository{
-					getBulkNews: getBulkNews{err: fmt.Errorf("AXDCZ")},
+					restoreBulkNews: restoreBulkNews{err: fmt.Errorf("AXDCZ")},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{
-					getObject:  getObject{err: fmt.Errorf("any")},
-					saveObject: saveObject{err: fmt.Errorf("any")},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+			},
+			in:      inputParam{newsID: 123},
+			mustErr: true,
+		},
+		{
+			name: "Failed - News not deleted",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					restoreBulkNews: restoreBulkNews{restoredID: nil},
 				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
 			},
-			in:         inputParam{newsID: 123},
-			mustReturn: presentation.GetNewsResponse{},
-			mustErr:    true,
+			in:      inputParam{newsID: 123},
+			mustErr: true,
 		},
 		{
 			name: "Success - Repo return no error",
 			repository: &Repositories{
 				NewsDataRepository: &MockNewsRepository{
-					getBulkNews: getBulkNews{
-						res: []presentation.GetNewsResponse{
-							{
-								ID:         1,
-								CreatedAt:  now,
-								UpdatedAt:  now,
-								Title:      "ABCDE",
-								Content:    "EFGH",
-								TopicsName: "AAA",
-								TagsName:   "ADWD",
-								Status:     1,
-							},
-						},
-						err: nil,
-					},
-				},
-				NewsRedisRepository: &MockNewsRedisRepository{
-					getObject:  getObject{fmt.Errorf("any")},
-					saveObject: saveObject{nil},
+					restoreBulkNews: restoreBulkNews{restoredID: []int{123}},
 				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{fmt.Errorf("any")}},
 			},
-			in: inputParam{newsID: 124312},
-			mustReturn: presentation.GetNewsResponse{
-				ID:         1,
-				CreatedAt:  now,
-				UpdatedAt:  now,
-				Title:      "ABCDE",
-				Content:    "EFGH",
-				TopicsName: "AAA",
-				TagsName:   "ADWD",
-				Status:     1,
-			},
+			in:      inputParam{newsID: 123},
 			mustErr: false,
 		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(tt *testing.T) {
-			uc := Usecase{
-				repositories: tc.repository,
-			}
-			c := gin.Context{
-				Request: &http.Request{RequestURI: "ASD"},
+			uc := Usecase{repositories: tc.repository}
+
+			err := uc.RestoreSingleNews(context.Background(), tc.in.newsID)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_RestoreSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
 			}
-			log.Println(c.Request.RequestURI)
-			got, err := uc.GetSingleNews(&c, tc.in.newsID)
+		})
+	}
+}
+
+func Test_PurgeDeletedNews(t *testing.T) {
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					purgeDeletedNews: purgeDeletedNews{err: fmt.Errorf("AXDCZ")},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Repo return no error",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					purgeDeletedNews: purgeDeletedNews{purgedID: []int{1, 2}},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+			},
+			mustReturn: []int{1, 2},
+		},
+	}
 
-			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, got) {
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{repositories: tc.repository}
+
+			res, err := uc.PurgeDeletedNews(context.Background(), time.Hour)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
-					FunctionName: "Test_GetSingleNews",
+					FunctionName: "Test_PurgeDeletedNews",
 					Description:  "Testcase run unsuccessfully",
-					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
 				}.Error())
 			}
 		})
 	}
 }
 
-func Test_GetNews(t *testing.T) {
+func Test_GetSingleNews(t *testing.T) {
 	type inputParam struct {
-		paginationString string
-		filterString     string
+		newsID int
 	}
 
 	now := time.Now()
@@ -316,9 +530,11 @@ func Test_GetNews(t *testing.T) {
 	testcases := []struct {
 		name       string
 		repository *Repositories
+		options    Options
 		in         inputParam
-		mustReturn []presentation.GetNewsResponse
+		mustReturn presentation.GetNewsResponse
 		mustErr    bool
+		mustErrIs  error
 	}{
 		{
 			name: "Failed - Repo return error",
@@ -331,28 +547,180 @@ func Test_GetNews(t *testing.T) {
 					saveObject: saveObject{err: fmt.Errorf("any")},
 				},
 			},
-			in: inputParam{
-				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
-				filterString:     "",
-			},
-			mustReturn: nil,
+			in:         inputParam{newsID: 123},
+			mustReturn: presentation.GetNewsResponse{},
 			mustErr:    true,
 		},
 		{
-			name: "Failed - No Pagination & No Filter",
+			name: "Failed - News Not Found",
 			repository: &Repositories{
 				NewsDataRepository: &MockNewsRepository{
-					getBulkNews: getBulkNews{
-						res: []presentation.GetNewsResponse{
-							{
-								ID:         1,
-								CreatedAt:  now,
-								UpdatedAt:  now,
-								Title:      "ABCDE",
-								Content:    "EFGH",
-								TopicsName: "AAA",
-								TagsName:   "ADWD",
-								Status:     1,
+					getBulkNews: getBulkNews{res: nil},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject:  getObject{err: fmt.Errorf("any")},
+					saveObject: saveObject{err: fmt.Errorf("any")},
+				},
+			},
+			in:         inputParam{newsID: 123},
+			mustReturn: presentation.GetNewsResponse{},
+			mustErr:    true,
+			mustErrIs:  ErrNewsNotFound,
+		},
+		{
+			name: "Success - Repo return no error",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{
+						res: []presentation.GetNewsResponse{
+							{
+								ID:         1,
+								CreatedAt:  now,
+								UpdatedAt:  now,
+								Title:      "ABCDE",
+								Content:    "EFGH",
+								TopicsName: "AAA",
+								TagsName:   "ADWD",
+								Status:     1,
+							},
+						},
+						err: nil,
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject:  getObject{err: fmt.Errorf("any")},
+					saveObject: saveObject{nil},
+				},
+			},
+			options: Options{LegacyAssocNames: true},
+			in:      inputParam{newsID: 124312},
+			mustReturn: presentation.GetNewsResponse{
+				ID:         1,
+				CreatedAt:  now,
+				UpdatedAt:  now,
+				Title:      "ABCDE",
+				Content:    "EFGH",
+				TopicsName: "AAA",
+				TagsName:   "ADWD",
+				Status:     1,
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success - Legacy Names Omitted",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{
+						res: []presentation.GetNewsResponse{
+							{
+								ID:         1,
+								Title:      "ABCDE",
+								Topics:     presentation.NewsAssocItems{{ID: 1, Name: "AAA"}},
+								Tags:       presentation.NewsAssocItems{{ID: 2, Name: "ADWD"}},
+								TopicsName: "AAA",
+								TagsName:   "ADWD",
+							},
+						},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject:  getObject{err: fmt.Errorf("any")},
+					saveObject: saveObject{nil},
+				},
+			},
+			in: inputParam{newsID: 1},
+			mustReturn: presentation.GetNewsResponse{
+				ID:     1,
+				Title:  "ABCDE",
+				Topics: presentation.NewsAssocItems{{ID: 1, Name: "AAA"}},
+				Tags:   presentation.NewsAssocItems{{ID: 2, Name: "ADWD"}},
+			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+				options:      tc.options,
+			}
+			got, err := uc.GetSingleNews(context.Background(), tc.in.newsID)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) || !reflect.DeepEqual(tc.mustReturn, got) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetNews(t *testing.T) {
+	type inputParam struct {
+		paginationString string
+		filterString     string
+		sortString       string
+	}
+
+	now := time.Now()
+
+	encode := func(v interface{}) string {
+		encoded, _ := urlutils.EncodeStruct(v)
+		return encoded
+	}
+
+	yesterday := now.Add(-24 * time.Hour)
+
+	nextCursor := encode(presentation.NewsCursor{CreatedAt: now, ID: 1, Direction: presentation.CURSOR_DIRECTION_NEXT})
+	prevCursor := encode(presentation.NewsCursor{CreatedAt: now, ID: 1, Direction: presentation.CURSOR_DIRECTION_PREV})
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		options    Options
+		in         inputParam
+		mustReturn []presentation.GetNewsResponse
+		mustMeta   *presentation.PaginationMeta
+		mustErr    bool
+		mustErrIs  error
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{err: fmt.Errorf("AXDCZ")},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject:  getObject{err: fmt.Errorf("any")},
+					saveObject: saveObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+				filterString:     "",
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Failed - No Pagination & No Filter",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{
+						res: []presentation.GetNewsResponse{
+							{
+								ID:         1,
+								CreatedAt:  now,
+								UpdatedAt:  now,
+								Title:      "ABCDE",
+								Content:    "EFGH",
+								TopicsName: "AAA",
+								TagsName:   "ADWD",
+								Status:     1,
 							},
 						},
 					},
@@ -374,6 +742,7 @@ func Test_GetNews(t *testing.T) {
 			repository: &Repositories{
 				NewsDataRepository: &MockNewsRepository{
 					getBulkNews: getBulkNews{
+						total: 10,
 						res: []presentation.GetNewsResponse{
 							{
 								ID:         1,
@@ -394,6 +763,7 @@ func Test_GetNews(t *testing.T) {
 					saveObject: saveObject{err: fmt.Errorf("any")},
 				},
 			},
+			options: Options{LegacyAssocNames: true},
 			in: inputParam{
 				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
 				filterString:     "",
@@ -410,7 +780,164 @@ func Test_GetNews(t *testing.T) {
 					Status:     1,
 				},
 			},
-			mustErr: false,
+			mustMeta: &presentation.PaginationMeta{Total: 10, Offset: 1, Count: 1, HasMore: true, NextCursor: nextCursor, PrevCursor: prevCursor},
+			mustErr:  false,
+		},
+		{
+			name: "Success - Sorted Page Without Cursors",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{
+						total: 10,
+						res:   []presentation.GetNewsResponse{{ID: 1, CreatedAt: now, UpdatedAt: now, Title: "ABCDE", Content: "EFGH", Status: 1}},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject:  getObject{err: fmt.Errorf("any")},
+					saveObject: saveObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
+				sortString:       "title",
+			},
+			mustReturn: []presentation.GetNewsResponse{{ID: 1, CreatedAt: now, UpdatedAt: now, Title: "ABCDE", Content: "EFGH", Status: 1}},
+			mustMeta:   &presentation.PaginationMeta{Total: 10, Offset: 1, Count: 1, HasMore: true},
+			mustErr:    false,
+		},
+		{
+			name: "Failed - Invalid Filter Match",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1}),
+				filterString:     encode(presentation.NewsFilter{Topics: []int{1}, TopicsMatch: "most"}),
+			},
+			mustReturn: nil,
+			mustErr:    true,
+			mustErrIs:  ErrInvalidFilter,
+		},
+		{
+			name: "Failed - Invalid Filter Date Range",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1}),
+				filterString:     encode(presentation.NewsFilter{CreatedFrom: &now, CreatedTo: &yesterday}),
+			},
+			mustReturn: nil,
+			mustErr:    true,
+			mustErrIs:  ErrInvalidFilter,
+		},
+		{
+			name: "Failed - Invalid Filter Status",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1}),
+				filterString:     encode(presentation.NewsFilter{Statuses: []int{presentation.NEWS_STATUS_PUBLISHED, 99}}),
+			},
+			mustReturn: nil,
+			mustErr:    true,
+			mustErrIs:  ErrInvalidFilter,
+		},
+		{
+			name: "Failed - Invalid Cursor",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1, Cursor: "%%"}),
+			},
+			mustReturn: nil,
+			mustErr:    true,
+			mustErrIs:  ErrInvalidCursor,
+		},
+		{
+			name: "Failed - Cursor Without Position",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1, Cursor: encode(presentation.NewsCursor{Direction: presentation.CURSOR_DIRECTION_NEXT})}),
+			},
+			mustReturn: nil,
+			mustErr:    true,
+			mustErrIs:  ErrInvalidCursor,
+		},
+		{
+			name: "Failed - Sort On Cursor",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1, Cursor: nextCursor}),
+				sortString:       "title",
+			},
+			mustReturn: nil,
+			mustErr:    true,
+			mustErrIs:  ErrInvalidCursor,
+		},
+		{
+			name: "Success - Next Cursor Last Page",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{
+						total: 1,
+						res:   []presentation.GetNewsResponse{{ID: 1, CreatedAt: now, UpdatedAt: now}},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1, Offset: 5, Cursor: nextCursor}),
+			},
+			mustReturn: []presentation.GetNewsResponse{{ID: 1, CreatedAt: now, UpdatedAt: now}},
+			mustMeta:   &presentation.PaginationMeta{Total: 1, Offset: 0, Count: 1, HasMore: false, PrevCursor: prevCursor},
+			mustErr:    false,
+		},
+		{
+			name: "Success - Prev Cursor First Page",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{
+						total: 1,
+						res:   []presentation.GetNewsResponse{{ID: 1, CreatedAt: now, UpdatedAt: now}},
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			in: inputParam{
+				paginationString: encode(presentation.Pagination{Count: 1, Cursor: prevCursor}),
+			},
+			mustReturn: []presentation.GetNewsResponse{{ID: 1, CreatedAt: now, UpdatedAt: now}},
+			mustMeta:   &presentation.PaginationMeta{Total: 1, Offset: 0, Count: 1, HasMore: false, NextCursor: nextCursor},
+			mustErr:    false,
 		},
 		{
 			name: "Success - With Filter String",
@@ -437,6 +964,7 @@ func Test_GetNews(t *testing.T) {
 					saveObject: saveObject{err: fmt.Errorf("any")},
 				},
 			},
+			options: Options{LegacyAssocNames: true},
 			in: inputParam{
 				paginationString: "eyJvZmZzZXQiIDogMSwgImNvdW50IiA6IDd9",
 				filterString:     "eyJ0aXRsZSIgOiAiYXZkZSJ9",
@@ -461,14 +989,12 @@ func Test_GetNews(t *testing.T) {
 		t.Run(tc.name, func(tt *testing.T) {
 			uc := Usecase{
 				repositories: tc.repository,
+				options:      tc.options,
 			}
 
-			c := gin.Context{
-				Request: &http.Request{RequestURI: "Aasda"},
-			}
-			got, err := uc.GetNews(&c, tc.in.paginationString, tc.in.filterString)
+			got, err := uc.GetNews(context.Background(), tc.in.paginationString, tc.in.filterString, tc.in.sortString)
 
-			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, got) {
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) || !reflect.DeepEqual(tc.mustReturn, got.Data) || (tc.mustMeta != nil && !reflect.DeepEqual(got.Meta, *tc.mustMeta)) {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
 					FunctionName: "Test_GetNews",
@@ -511,7 +1037,7 @@ func Test_AssignNewsWithNewsTopic(t *testing.T) {
 					},
 				},
 				NewsRedisRepository: &MockNewsRedisRepository{
-					flushAll: flushAll{nil},
+					invalidateTags: invalidateTags{nil},
 				},
 			},
 			in: presentation.CreateNewsTopicsAssoc{
@@ -528,7 +1054,7 @@ func Test_AssignNewsWithNewsTopic(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			err := uc.AssignNewsWithNewsTopic(tc.in)
+			err := uc.AssignNewsWithNewsTopic(context.Background(), tc.in)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
@@ -573,9 +1099,161 @@ func Test_AssignNewsWithNewsTags(t *testing.T) {
 					},
 				},
 				NewsRedisRepository: &MockNewsRedisRepository{
-					flushAll: flushAll{nil},
+					invalidateTags: invalidateTags{nil},
+				},
+			},
+			in: presentation.CreateNewsTagsAssoc{
+				NewsID:    1,
+				NewsTagID: []int{1, 2, 3},
+			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			err := uc.AssignNewsWithNewsTag(context.Background(), tc.in)
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateSingleNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_ReassignNewsTopics(t *testing.T) {
+	testcases := []struct {
+		name           string
+		transaction    *MockTransactionRepository
+		in             presentation.CreateNewsTopicsAssoc
+		mustErr        bool
+		mustRolledBack bool
+	}{
+		{
+			name: "Failed - Clean return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					cleanNewsTopicsAssoc: cleanNewsTopicsAssoc{err: fmt.Errorf("awd")},
+				},
+			}},
+			in: presentation.CreateNewsTopicsAssoc{
+				NewsID:       1,
+				NewsTopicsID: []int{1, 2, 3},
+			},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Failed - Create return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsTopicsAssoc: createBulkNewsTopicsAssoc{err: fmt.Errorf("awd")},
+				},
+			}},
+			in: presentation.CreateNewsTopicsAssoc{
+				NewsID:       1,
+				NewsTopicsID: []int{1, 2, 3},
+			},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Success - Clear All Topics",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsTopicsAssoc: createBulkNewsTopicsAssoc{err: fmt.Errorf("must not be called")},
 				},
+			}},
+			in: presentation.CreateNewsTopicsAssoc{
+				NewsID: 1,
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success - Repo return no error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{},
+			}},
+			in: presentation.CreateNewsTopicsAssoc{
+				NewsID:       1,
+				NewsTopicsID: []int{1, 2, 3},
 			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: &Repositories{
+					TransactionRepository: tc.transaction,
+					NewsRedisRepository:   &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+				},
+			}
+
+			err := uc.ReassignNewsTopics(context.Background(), tc.in)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || tc.mustRolledBack != tc.transaction.rolledBack {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_ReassignNewsTopics",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v, mustRolledBack %v, rolledBack %v", tc.mustErr, err, tc.mustRolledBack, tc.transaction.rolledBack),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_ReassignNewsTags(t *testing.T) {
+	testcases := []struct {
+		name           string
+		transaction    *MockTransactionRepository
+		in             presentation.CreateNewsTagsAssoc
+		mustErr        bool
+		mustRolledBack bool
+	}{
+		{
+			name: "Failed - Clean return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					cleanNewsTagsAssoc: cleanNewsTagsAssoc{err: fmt.Errorf("awd")},
+				},
+			}},
+			in: presentation.CreateNewsTagsAssoc{
+				NewsID:    1,
+				NewsTagID: []int{1, 2, 3},
+			},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Failed - Create return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsTagsAssoc: createBulkNewsTagsAssoc{err: fmt.Errorf("awd")},
+				},
+			}},
+			in: presentation.CreateNewsTagsAssoc{
+				NewsID:    1,
+				NewsTagID: []int{1, 2, 3},
+			},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Success - Repo return no error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{},
+			}},
 			in: presentation.CreateNewsTagsAssoc{
 				NewsID:    1,
 				NewsTagID: []int{1, 2, 3},
@@ -584,13 +1262,78 @@ func Test_AssignNewsWithNewsTags(t *testing.T) {
 		},
 	}
 
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: &Repositories{
+					TransactionRepository: tc.transaction,
+					NewsRedisRepository:   &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+				},
+			}
+
+			err := uc.ReassignNewsTags(context.Background(), tc.in)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || tc.mustRolledBack != tc.transaction.rolledBack {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_ReassignNewsTags",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v, mustRolledBack %v, rolledBack %v", tc.mustErr, err, tc.mustRolledBack, tc.transaction.rolledBack),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_AssignNewsWithNewsAuthors(t *testing.T) {
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		in         presentation.CreateNewsAuthorsAssoc
+		mustErr    bool
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsAuthorsAssoc: createBulkNewsAuthorsAssoc{
+						err: fmt.Errorf("awd"),
+					},
+				},
+			},
+			in: presentation.CreateNewsAuthorsAssoc{
+				NewsID:       1,
+				NewsAuthorID: []int{1, 2, 3},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Repo return no error",
+			repository: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsAuthorsAssoc: createBulkNewsAuthorsAssoc{
+						err: nil,
+					},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					invalidateTags: invalidateTags{nil},
+				},
+			},
+			in: presentation.CreateNewsAuthorsAssoc{
+				NewsID:       1,
+				NewsAuthorID: []int{1, 2, 3},
+			},
+			mustErr: false,
+		},
+	}
+
 	for _, tc := range testcases {
 		t.Run(tc.name, func(tt *testing.T) {
 			uc := Usecase{
 				repositories: tc.repository,
 			}
 
-			err := uc.AssignNewsWithNewsTag(tc.in)
+			err := uc.AssignNewsWithNewsAuthor(context.Background(), tc.in)
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
@@ -602,3 +1345,194 @@ func Test_AssignNewsWithNewsTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_ReassignNewsAuthors(t *testing.T) {
+	testcases := []struct {
+		name           string
+		transaction    *MockTransactionRepository
+		in             presentation.CreateNewsAuthorsAssoc
+		mustErr        bool
+		mustRolledBack bool
+	}{
+		{
+			name: "Failed - Clean return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					cleanNewsAuthorsAssoc: cleanNewsAuthorsAssoc{err: fmt.Errorf("awd")},
+				},
+			}},
+			in: presentation.CreateNewsAuthorsAssoc{
+				NewsID:       1,
+				NewsAuthorID: []int{1, 2, 3},
+			},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Failed - Create return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{
+					createBulkNewsAuthorsAssoc: createBulkNewsAuthorsAssoc{err: fmt.Errorf("awd")},
+				},
+			}},
+			in: presentation.CreateNewsAuthorsAssoc{
+				NewsID:       1,
+				NewsAuthorID: []int{1, 2, 3},
+			},
+			mustErr:        true,
+			mustRolledBack: true,
+		},
+		{
+			name: "Success - Repo return no error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				AssignNewsAssocRepository: &MockAssignNewsAssocRepository{},
+			}},
+			in: presentation.CreateNewsAuthorsAssoc{
+				NewsID:       1,
+				NewsAuthorID: []int{1, 2, 3},
+			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: &Repositories{
+					TransactionRepository: tc.transaction,
+					NewsRedisRepository:   &MockNewsRedisRepository{invalidateTags: invalidateTags{nil}},
+				},
+			}
+
+			err := uc.ReassignNewsAuthors(context.Background(), tc.in)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || tc.mustRolledBack != tc.transaction.rolledBack {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_ReassignNewsAuthors",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v, mustRolledBack %v, rolledBack %v", tc.mustErr, err, tc.mustRolledBack, tc.transaction.rolledBack),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_SearchNews(t *testing.T) {
+	now := time.Now()
+
+	pagination, _ := urlutils.EncodeStruct(presentation.Pagination{Offset: 0, Count: 1})
+	cursorPagination, _ := urlutils.EncodeStruct(presentation.Pagination{Count: 1, Cursor: "abc"})
+	filter, _ := urlutils.EncodeStruct(presentation.NewsFilter{Status: presentation.NEWS_STATUS_PUBLISHED})
+
+	result := []presentation.SearchNewsResponse{
+		{
+			GetNewsResponse:  presentation.GetNewsResponse{ID: 1, CreatedAt: now, UpdatedAt: now, Title: "A", TopicsName: "X"},
+			Rank:             0.5,
+			TitleHighlight:   "<mark>A</mark>",
+			ContentHighlight: "",
+		},
+	}
+
+	testcases := []struct {
+		name             string
+		repository       *Repositories
+		options          Options
+		paginationString string
+		filterString     string
+		mustReturn       []presentation.SearchNewsResponse
+		mustMeta         *presentation.PaginationMeta
+		mustErr          bool
+	}{
+		{
+			name: "Failed - Invalid Pagination",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			paginationString: "%%",
+			mustErr:          true,
+		},
+		{
+			name: "Failed - Cursor Pagination",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			paginationString: cursorPagination,
+			mustErr:          true,
+		},
+		{
+			name: "Failed - Invalid Filter",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			paginationString: pagination,
+			filterString:     "%%",
+			mustErr:          true,
+		},
+		{
+			name: "Failed - Repo Return Error",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					searchNews: searchNews{err: fmt.Errorf("any")},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject: getObject{err: fmt.Errorf("any")},
+				},
+			},
+			paginationString: pagination,
+			mustErr:          true,
+		},
+		{
+			name: "Success - Legacy Names Omitted",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					searchNews: searchNews{res: result, total: 4},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{
+					getObject:  getObject{err: fmt.Errorf("any")},
+					saveObject: saveObject{err: fmt.Errorf("any")},
+				},
+			},
+			paginationString: pagination,
+			filterString:     filter,
+			mustReturn: []presentation.SearchNewsResponse{
+				{
+					GetNewsResponse:  presentation.GetNewsResponse{ID: 1, CreatedAt: now, UpdatedAt: now, Title: "A"},
+					Rank:             0.5,
+					TitleHighlight:   "<mark>A</mark>",
+					ContentHighlight: "",
+				},
+			},
+			mustMeta: &presentation.PaginationMeta{Total: 4, Offset: 0, Count: 1, HasMore: true},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+				options:      tc.options,
+			}
+
+			got, err := uc.SearchNews(context.Background(), "a", tc.paginationString, tc.filterString)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, got.Data) || (tc.mustMeta != nil && !reflect.DeepEqual(got.Meta, *tc.mustMeta)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_SearchNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", got, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -1,29 +1,32 @@
 package usecase
 
+import "context"
+
 type MockNewsRedisRepository struct {
-	getObject  getObject
-	saveObject saveObject
-	flushAll   flushAll
+	getObject      getObject
+	saveObject     saveObject
+	invalidateTags invalidateTags
 }
 
 type getObject struct {
-	err error
+	stale bool
+	err   error
 }
 
 type saveObject struct {
 	err error
 }
 
-type flushAll struct {
+type invalidateTags struct {
 	err error
 }
 
-func (mnrr *MockNewsRedisRepository) GetObject(key string, dest interface{}) error {
-	return mnrr.getObject.err
+func (mnrr *MockNewsRedisRepository) GetObject(ctx context.Context, key string, dest interface{}) (bool, error) {
+	return mnrr.getObject.stale, mnrr.getObject.err
 }
-func (mnrr *MockNewsRedisRepository) SaveObject(key string, value interface{}) error {
+func (mnrr *MockNewsRedisRepository) SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error {
 	return mnrr.saveObject.err
 }
-func (mnrr *MockNewsRedisRepository) FlushAll() error {
-	return mnrr.flushAll.err
+func (mnrr *MockNewsRedisRepository) InvalidateTags(ctx context.Context, tags ...string) error {
+	return mnrr.invalidateTags.err
 }
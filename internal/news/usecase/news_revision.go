@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/textdiff"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+// GetNewsRevisions return content history of news, newest first
+func (uc *Usecase) GetNewsRevisions(ctx context.Context, newsID int) ([]presentation.NewsRevision, error) {
+	res, err := uc.repositories.GetNewsRevisions(ctx, newsID)
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "UC",
+			Name:         "News Revision",
+			FunctionName: "GetNewsRevisions",
+			Description:  "Failed running repository",
+			Trace:        err,
+		}.Error()
+	}
+
+	if res == nil {
+		res = []presentation.NewsRevision{}
+	}
+
+	return res, nil
+}
+
+// GetNewsRevision return single revision of news, missing one return ErrRevisionNotFound
+func (uc *Usecase) GetNewsRevision(ctx context.Context, newsID, revision int) (presentation.NewsRevision, error) {
+	revisions, err := uc.getRevisions(ctx, "GetNewsRevision", newsID, revision)
+	if err != nil {
+		return presentation.NewsRevision{}, err
+	}
+
+	return revisions[revision], nil
+}
+
+// DiffNewsRevisions return line based diff of title and content turning revision from into revision to
+func (uc *Usecase) DiffNewsRevisions(ctx context.Context, newsID, from, to int) (presentation.NewsRevisionDiff, error) {
+	revisions, err := uc.getRevisions(ctx, "DiffNewsRevisions", newsID, from, to)
+	if err != nil {
+		return presentation.NewsRevisionDiff{}, err
+	}
+
+	return presentation.NewsRevisionDiff{
+		NewsID:  newsID,
+		From:    from,
+		To:      to,
+		Title:   diffLines(revisions[from].Title, revisions[to].Title),
+		Content: diffLines(revisions[from].Content, revisions[to].Content),
+	}, nil
+}
+
+// RestoreNewsRevision write content and schedule of old revision back to news, recorded as new revision.
+// Status is kept, it is only changed through workflow transitions
+func (uc *Usecase) RestoreNewsRevision(ctx context.Context, newsID, revision int) error {
+	err := uc.authorizeNewsChange(ctx, "RestoreNewsRevision", newsID)
+	if err != nil {
+		return err
+	}
+
+	// Revision written moments ago may not reach replica yet
+	revisions, err := uc.getRevisions(dbutils.WithPrimaryRead(ctx), "RestoreNewsRevision", newsID, revision)
+	if err != nil {
+		return err
+	}
+
+	restored := presentation.UpdateNewsRequest{
+		ID:           newsID,
+		Title:        revisions[revision].Title,
+		Content:      revisions[revision].Content,
+		PublishAt:    revisions[revision].PublishAt,
+		UnpublishAt:  revisions[revision].UnpublishAt,
+		RestoredFrom: revision,
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		restored.UpdatedBy = principal.Subject
+	}
+
+	updatedID, err := uc.repositories.UpdateBulkNews(ctx, []presentation.UpdateNewsRequest{restored})
+	if err != nil {
+		return err
+	}
+
+	// Revision outlive soft deletion, but deleted news is not updated
+	if len(updatedID) <= 0 {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Revision",
+			FunctionName: "RestoreNewsRevision",
+			Description:  "Data Not Found or deleted",
+			Trace:        ErrNewsNotFound,
+		}.Error()
+	}
+
+	uc.invalidateCache(ctx, "RestoreNewsRevision", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, newsID))
+
+	return nil
+}
+
+// getRevisions read the revisions of news by number, any of them missing return ErrRevisionNotFound
+func (uc *Usecase) getRevisions(ctx context.Context, functionName string, newsID int, revision ...int) (map[int]presentation.NewsRevision, error) {
+	res, err := uc.repositories.GetNewsRevisions(ctx, newsID, revision...)
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "UC",
+			Name:         "News Revision",
+			FunctionName: functionName,
+			Description:  "Failed running repository",
+			Trace:        err,
+		}.Error()
+	}
+
+	revisions := make(map[int]presentation.NewsRevision, len(res))
+	for _, r := range res {
+		revisions[r.Revision] = r
+	}
+
+	for _, r := range revision {
+		if _, ok := revisions[r]; !ok {
+			return nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Revision",
+				FunctionName: functionName,
+				Description:  fmt.Sprintf("revision %d of news %d", r, newsID),
+				Trace:        ErrRevisionNotFound,
+			}.Error()
+		}
+	}
+
+	return revisions, nil
+}
+
+func diffLines(a, b string) []presentation.DiffLine {
+	lines := textdiff.Lines(a, b)
+
+	res := make([]presentation.DiffLine, 0, len(lines))
+	for _, l := range lines {
+		res = append(res, presentation.DiffLine{Op: l.Op, Text: l.Text})
+	}
+
+	return res
+}
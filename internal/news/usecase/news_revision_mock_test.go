@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+type MockNewsRevisionRepository struct {
+	getNewsRevisions getNewsRevisions
+}
+
+type getNewsRevisions struct {
+	res []presentation.NewsRevision
+	err error
+}
+
+func (mnrr *MockNewsRevisionRepository) GetNewsRevisions(ctx context.Context, newsID int, revision ...int) ([]presentation.NewsRevision, error) {
+	return mnrr.getNewsRevisions.res, mnrr.getNewsRevisions.err
+}
@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/pkg/textdiff"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"testing"
+)
+
+func Test_GetNewsRevisions(t *testing.T) {
+	revisions := []presentation.NewsRevision{
+		{ID: 2, NewsID: 1, Revision: 2, Title: "A", Content: "C"},
+		{ID: 1, NewsID: 1, Revision: 1, Title: "A", Content: "B"},
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		mustReturn []presentation.NewsRevision
+		mustErr    bool
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{err: fmt.Errorf("AXDCZ")},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - No Revision",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{},
+			},
+			mustReturn: []presentation.NewsRevision{},
+		},
+		{
+			name: "Success",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions},
+				},
+			},
+			mustReturn: revisions,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			res, err := uc.GetNewsRevisions(context.Background(), 1)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetNewsRevisions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetNewsRevision(t *testing.T) {
+	revision := presentation.NewsRevision{ID: 2, NewsID: 1, Revision: 2, Title: "A", Content: "C"}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		mustReturn presentation.NewsRevision
+		mustErr    bool
+		mustErrIs  error
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{err: fmt.Errorf("AXDCZ")},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Revision Not Found",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{},
+			},
+			mustErr:   true,
+			mustErrIs: ErrRevisionNotFound,
+		},
+		{
+			name: "Success",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: []presentation.NewsRevision{revision}},
+				},
+			},
+			mustReturn: revision,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			res, err := uc.GetNewsRevision(context.Background(), 1, 2)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetNewsRevision",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_DiffNewsRevisions(t *testing.T) {
+	revisions := []presentation.NewsRevision{
+		{ID: 3, NewsID: 1, Revision: 3, Title: "A", Content: "a\nB\nc"},
+		{ID: 1, NewsID: 1, Revision: 1, Title: "A", Content: "a\nb\nc"},
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		mustReturn presentation.NewsRevisionDiff
+		mustErr    bool
+		mustErrIs  error
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{err: fmt.Errorf("AXDCZ")},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - One Revision Not Found",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions[1:]},
+				},
+			},
+			mustErr:   true,
+			mustErrIs: ErrRevisionNotFound,
+		},
+		{
+			name: "Success",
+			repository: &Repositories{
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions},
+				},
+			},
+			mustReturn: presentation.NewsRevisionDiff{
+				NewsID: 1,
+				From:   1,
+				To:     3,
+				Title:  []presentation.DiffLine{{Op: textdiff.OP_EQUAL, Text: "A"}},
+				Content: []presentation.DiffLine{
+					{Op: textdiff.OP_EQUAL, Text: "a"},
+					{Op: textdiff.OP_DELETE, Text: "b"},
+					{Op: textdiff.OP_INSERT, Text: "B"},
+					{Op: textdiff.OP_EQUAL, Text: "c"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			res, err := uc.DiffNewsRevisions(context.Background(), 1, 1, 3)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_DiffNewsRevisions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_RestoreNewsRevision(t *testing.T) {
+	owner := "writer-1"
+	revisions := []presentation.NewsRevision{{ID: 1, NewsID: 1, Revision: 1, Title: "A", Content: "B"}}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		principal  *auth.Principal
+		mustErr    bool
+		mustErrIs  error
+	}{
+		{
+			name: "Failed - Writer Restore Other News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, CreatedBy: &owner}}},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions},
+				},
+			},
+			principal: &auth.Principal{Subject: "writer-2", Role: auth.ROLE_WRITER},
+			mustErr:   true,
+			mustErrIs: auth.ErrForbidden,
+		},
+		{
+			name: "Failed - Revision Not Found",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRevisionRepository: &MockNewsRevisionRepository{},
+			},
+			mustErr:   true,
+			mustErrIs: ErrRevisionNotFound,
+		},
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					updateBulkNews: updateBulkNews{err: fmt.Errorf("AXDCZ")},
+				},
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - News Deleted",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{},
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions},
+				},
+			},
+			mustErr:   true,
+			mustErrIs: ErrNewsNotFound,
+		},
+		{
+			name: "Success - Writer Restore Own News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews:    getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, CreatedBy: &owner}}},
+					updateBulkNews: updateBulkNews{updatedID: []int{1}},
+				},
+				NewsRevisionRepository: &MockNewsRevisionRepository{
+					getNewsRevisions: getNewsRevisions{res: revisions},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{fmt.Errorf("invalidate error is only logged")}},
+			},
+			principal: &auth.Principal{Subject: owner, Role: auth.ROLE_WRITER},
+			mustErr:   false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			ctx := context.Background()
+			if tc.principal != nil {
+				ctx = auth.WithPrincipal(ctx, *tc.principal)
+			}
+
+			err := uc.RestoreNewsRevision(ctx, 1, 1)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_RestoreNewsRevision",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, mustErrIs %v, err %v", tc.mustErr, tc.mustErrIs, err),
+				}.Error())
+			}
+		})
+	}
+}
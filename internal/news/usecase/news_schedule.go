@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+// NEWS_SCHEDULER_SUBJECT is recorded as the author of transitions made by the news schedule
+const NEWS_SCHEDULER_SUBJECT = "scheduler"
+
+// RunNewsSchedule publish approved news whose publish_at has come, and archive published news whose unpublish_at has come.
+// Only the instance holding the schedule lock run it, the others return without change.
+// Transitions follow the editorial workflow, so news not yet approved is left until it is
+func (uc *Usecase) RunNewsSchedule(ctx context.Context) (published, unpublished []int, err error) {
+	err = uc.repositories.WithTx(ctx, func(tx TxRepositories) error {
+		locked, err := tx.TryLockNewsSchedule(ctx)
+		if err != nil || !locked {
+			return err
+		}
+
+		publish := newsTransitions[presentation.NEWS_TRANSITION_PUBLISH]
+		published, err = tx.TransitionDueNews(ctx, presentation.NEWS_SCHEDULE_PUBLISH, presentation.NEWS_TRANSITION_PUBLISH, publish.from, publish.to, NEWS_SCHEDULER_SUBJECT)
+		if err != nil {
+			return err
+		}
+
+		unpublish := newsTransitions[presentation.NEWS_TRANSITION_UNPUBLISH]
+		unpublished, err = tx.TransitionDueNews(ctx, presentation.NEWS_SCHEDULE_UNPUBLISH, presentation.NEWS_TRANSITION_UNPUBLISH, unpublish.from, unpublish.to, NEWS_SCHEDULER_SUBJECT)
+		return err
+	})
+	if err != nil {
+		return nil, nil, response.InternalError{
+			Type:         "UC",
+			Name:         "News Schedule",
+			FunctionName: "RunNewsSchedule",
+			Description:  "Failed running repository",
+			Trace:        err,
+		}.Error()
+	}
+
+	if len(published) <= 0 && len(unpublished) <= 0 {
+		return published, unpublished, nil
+	}
+
+	transitionedID := append(append([]int{}, published...), unpublished...)
+	uc.invalidateCache(ctx, "RunNewsSchedule", append(idCacheTags(CACHE_TAG_NEWS, transitionedID), CACHE_TAG_NEWS_LIST)...)
+
+	return published, unpublished, nil
+}
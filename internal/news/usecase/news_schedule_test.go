@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"testing"
+)
+
+func Test_RunNewsSchedule(t *testing.T) {
+	testcases := []struct {
+		name            string
+		transaction     *MockTransactionRepository
+		mustPublished   []int
+		mustUnpublished []int
+		mustErr         bool
+	}{
+		{
+			name:        "Failed - Begin Transaction Error",
+			transaction: &MockTransactionRepository{beginErr: fmt.Errorf("AXDCZ")},
+			mustErr:     true,
+		},
+		{
+			name: "Failed - Lock return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					tryLockNewsSchedule: tryLockNewsSchedule{err: fmt.Errorf("AXDCZ")},
+				},
+			}},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Unpublish return error",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					tryLockNewsSchedule: tryLockNewsSchedule{locked: true},
+					transitionDueNews: map[string]transitionDueNews{
+						presentation.NEWS_SCHEDULE_PUBLISH:   {transitionedID: []int{1}},
+						presentation.NEWS_SCHEDULE_UNPUBLISH: {err: fmt.Errorf("AXDCZ")},
+					},
+				},
+			}},
+			mustErr: true,
+		},
+		{
+			name: "Success - Other Instance Hold Lock",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					transitionDueNews: map[string]transitionDueNews{
+						presentation.NEWS_SCHEDULE_PUBLISH: {err: fmt.Errorf("must not run without lock")},
+					},
+				},
+			}},
+		},
+		{
+			name: "Success - Nothing Due",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					tryLockNewsSchedule: tryLockNewsSchedule{locked: true},
+				},
+			}},
+		},
+		{
+			name: "Success",
+			transaction: &MockTransactionRepository{tx: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					tryLockNewsSchedule: tryLockNewsSchedule{locked: true},
+					transitionDueNews: map[string]transitionDueNews{
+						presentation.NEWS_SCHEDULE_PUBLISH:   {transitionedID: []int{1, 2}},
+						presentation.NEWS_SCHEDULE_UNPUBLISH: {transitionedID: []int{3}},
+					},
+				},
+			}},
+			mustPublished:   []int{1, 2},
+			mustUnpublished: []int{3},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: &Repositories{
+					TransactionRepository: tc.transaction,
+					NewsRedisRepository:   &MockNewsRedisRepository{invalidateTags: invalidateTags{fmt.Errorf("invalidate error is only logged")}},
+				},
+			}
+
+			published, unpublished, err := uc.RunNewsSchedule(context.Background())
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(published, tc.mustPublished) || !reflect.DeepEqual(unpublished, tc.mustUnpublished) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_RunNewsSchedule",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v %v, expected %v %v, mustErr %v, err %v", published, unpublished, tc.mustPublished, tc.mustUnpublished, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
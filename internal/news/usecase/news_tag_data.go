@@ -1,38 +1,57 @@
 package usecase
 
 import (
-	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"context"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
 )
 
-func (uc *Usecase) CreateNewsTags(in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
-	return uc.repositories.CreateBulkNewsTags(in)
-}
-func (uc *Usecase) DeleteNewsTags(newsTopicID []int) (deletedID []int, err error) {
-	return uc.repositories.DeleteBulkNewsTags(newsTopicID)
+func (uc *Usecase) CreateNewsTags(ctx context.Context, in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
+	insertedID, err = uc.repositories.CreateBulkNewsTags(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateCache(ctx, "CreateNewsTags", CACHE_TAG_NEWS_TAG_LIST)
+	return insertedID, nil
 }
-func (uc *Usecase) UpdateNewsTags(newNewsTags []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
-	return uc.repositories.UpdateBulkNewsTags(newNewsTags)
+
+// DeleteNewsTags evict the tag listing and every cached news showing deleted tag
+func (uc *Usecase) DeleteNewsTags(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
+	deletedID, err = uc.repositories.DeleteBulkNewsTags(ctx, newsTopicID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateCache(ctx, "DeleteNewsTags", append(idCacheTags(CACHE_TAG_NEWS_TAG, newsTopicID), CACHE_TAG_NEWS_TAG_LIST)...)
+	return deletedID, nil
 }
-func (uc *Usecase) GetNewsTags(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsTagsResponse, err error) {
-	// Get From Redis First
-	var redisData []presentation.GetNewsTagsResponse
-	err = uc.repositories.GetObject(ctx.Request.RequestURI, &redisData)
-	if err == nil {
-		return redisData, nil
+
+// UpdateNewsTags evict the tag listing and every cached news showing updated tag
+func (uc *Usecase) UpdateNewsTags(ctx context.Context, newNewsTags []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
+	updatedID, err = uc.repositories.UpdateBulkNewsTags(ctx, newNewsTags)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]int, 0, len(newNewsTags))
+	for _, item := range newNewsTags {
+		id = append(id, item.ID)
 	}
 
-	// Get From Database
+	uc.invalidateCache(ctx, "UpdateNewsTags", append(idCacheTags(CACHE_TAG_NEWS_TAG, id), CACHE_TAG_NEWS_TAG_LIST)...)
+	return updatedID, nil
+}
+
+func (uc *Usecase) GetNewsTags(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsTagsListResponse, err error) {
 	var pagination *presentation.Pagination
 	var filter *presentation.NewsTagsFilter
 
 	if paginationString != "" {
 		err = urlutils.DecodeEncodedString(paginationString, &pagination)
 		if err != nil {
-			return nil, response.InternalError{
+			return res, response.InternalError{
 				Type:         "Usecase",
 				Name:         "News Data",
 				FunctionName: "GetNewsTags",
@@ -45,7 +64,7 @@ func (uc *Usecase) GetNewsTags(ctx *gin.Context, paginationString, filterString
 	if filterString != "" {
 		err = urlutils.DecodeEncodedString(filterString, &filter)
 		if err != nil {
-			return nil, response.InternalError{
+			return res, response.InternalError{
 				Type:         "Usecase",
 				Name:         "News Data",
 				FunctionName: "GetNewsTags",
@@ -55,27 +74,32 @@ func (uc *Usecase) GetNewsTags(ctx *gin.Context, paginationString, filterString
 		}
 	}
 
-	res, err = uc.repositories.GetBulkNewsTags(pagination, filter)
+	cacheKey, err := buildCacheKey(CACHE_NAMESPACE_NEWS_TAGS, pagination, filter, sortString)
 	if err != nil {
-		return nil, response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetNewsTags",
-			Description:  "Failed run Repository",
-			Trace:        err,
-		}.Error()
+		return res, err
 	}
 
-	// Save Result to Redis
-	err = uc.repositories.SaveObject(ctx.Request.RequestURI, res)
+	// Get From Redis First, then from Database
+	err = uc.cachedRead(ctx, "GetNewsTags", cacheKey, &res, func(ctx context.Context) (interface{}, []string, error) {
+		items, total, err := uc.repositories.GetBulkNewsTags(ctx, pagination, filter, sortString)
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "GetNewsTags",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+
+		// Cached together with pagination metadata
+		return presentation.GetNewsTagsListResponse{
+			Data: items,
+			Meta: presentation.NewPaginationMeta(pagination, len(items), total),
+		}, []string{CACHE_TAG_NEWS_TAG_LIST}, nil
+	})
 	if err != nil {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetNewsTags",
-			Description:  "Failed Store data to redis",
-			Trace:        err,
-		}.Error())
+		return res, err
 	}
 
 	return res, nil
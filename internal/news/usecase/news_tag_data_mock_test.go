@@ -1,6 +1,9 @@
 package usecase
 
-import "github.com/Mufidzz/bareksa-test/presentation"
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
 
 type MockNewsTagDataRepository struct {
 	createBulkNewsTags createBulkNewsTags
@@ -15,8 +18,9 @@ type createBulkNewsTags struct {
 }
 
 type getBulkNewsTags struct {
-	res []presentation.GetNewsTagsResponse
-	err error
+	res   []presentation.GetNewsTagsResponse
+	total int64
+	err   error
 }
 
 type updateBulkNewsTags struct {
@@ -29,15 +33,15 @@ type deleteBulkNewsTags struct {
 	err       error
 }
 
-func (mntdr *MockNewsTagDataRepository) CreateBulkNewsTags(in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
+func (mntdr *MockNewsTagDataRepository) CreateBulkNewsTags(ctx context.Context, in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
 	return mntdr.createBulkNewsTags.insertedID, mntdr.createBulkNewsTags.err
 }
-func (mntdr *MockNewsTagDataRepository) GetBulkNewsTags(pagination *presentation.Pagination, filter *presentation.NewsTagsFilter) (res []presentation.GetNewsTagsResponse, err error) {
-	return mntdr.getBulkNewsTags.res, mntdr.getBulkNewsTags.err
+func (mntdr *MockNewsTagDataRepository) GetBulkNewsTags(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsTagsFilter, sort string) (res []presentation.GetNewsTagsResponse, total int64, err error) {
+	return mntdr.getBulkNewsTags.res, mntdr.getBulkNewsTags.total, mntdr.getBulkNewsTags.err
 }
-func (mntdr *MockNewsTagDataRepository) UpdateBulkNewsTags(in []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
+func (mntdr *MockNewsTagDataRepository) UpdateBulkNewsTags(ctx context.Context, in []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
 	return mntdr.updateBulkNewsTags.updatedID, mntdr.updateBulkNewsTags.err
 }
-func (mntdr *MockNewsTagDataRepository) DeleteBulkNewsTags(newsTagID []int) (deletedID []int, err error) {
+func (mntdr *MockNewsTagDataRepository) DeleteBulkNewsTags(ctx context.Context, newsTagID []int) (deletedID []int, err error) {
 	return mntdr.deleteBulkNewsTags.deletedID, mntdr.deleteBulkNewsTags.err
 }
@@ -1,11 +1,10 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
-	"net/http"
 	"reflect"
 	"testing"
 )
@@ -25,6 +24,7 @@ func Test_CreateTags(t *testing.T) {
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					createBulkNewsTags: createBulkNewsTags{
 						err: fmt.Errorf("ASD"),
@@ -47,6 +47,7 @@ func Test_CreateTags(t *testing.T) {
 		{
 			name: "Success - Repo return no error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					createBulkNewsTags: createBulkNewsTags{
 						insertedID: []int{1, 2},
@@ -74,7 +75,7 @@ func Test_CreateTags(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			got, err := uc.CreateNewsTags(tc.in.in)
+			got, err := uc.CreateNewsTags(context.Background(), tc.in.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
@@ -103,6 +104,7 @@ func Test_DeleteNewsTags(t *testing.T) {
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					deleteBulkNewsTags: deleteBulkNewsTags{
 						err: fmt.Errorf("ASD"),
@@ -118,6 +120,7 @@ func Test_DeleteNewsTags(t *testing.T) {
 		{
 			name: "Success - Repo return no error, all deleted",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					deleteBulkNewsTags: deleteBulkNewsTags{
 						deletedID: []int{1, 2, 3, 4},
@@ -133,6 +136,7 @@ func Test_DeleteNewsTags(t *testing.T) {
 		{
 			name: "Success - Repo return no error, partially deleted",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					deleteBulkNewsTags: deleteBulkNewsTags{
 						deletedID: []int{1, 2},
@@ -153,7 +157,7 @@ func Test_DeleteNewsTags(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			got, err := uc.DeleteNewsTags(tc.in.in)
+			got, err := uc.DeleteNewsTags(context.Background(), tc.in.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
@@ -182,6 +186,7 @@ func Test_UpdateNewsTags(t *testing.T) {
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					updateBulkNewsTags: updateBulkNewsTags{
 						err: fmt.Errorf("ASD"),
@@ -213,6 +218,7 @@ func Test_UpdateNewsTags(t *testing.T) {
 		{
 			name: "Success - Repo return no error, all updated",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					updateBulkNewsTags: updateBulkNewsTags{
 						updatedID: []int{1, 2, 3, 4},
@@ -244,6 +250,7 @@ func Test_UpdateNewsTags(t *testing.T) {
 		{
 			name: "Success - Repo return no error, partially updated",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					updateBulkNewsTags: updateBulkNewsTags{
 						updatedID: []int{1, 2},
@@ -280,7 +287,7 @@ func Test_UpdateNewsTags(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			got, err := uc.UpdateNewsTags(tc.in.in)
+			got, err := uc.UpdateNewsTags(context.Background(), tc.in.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
@@ -306,6 +313,7 @@ func Test_GetNewsTags(t *testing.T) {
 		in         inputParam
 		mustErr    bool
 		mustReturn []presentation.GetNewsTagsResponse
+		mustMeta   *presentation.PaginationMeta
 	}{
 		{
 			name: "Failed - Repo return error",
@@ -315,7 +323,7 @@ func Test_GetNewsTags(t *testing.T) {
 						err: fmt.Errorf("ASD"),
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("X")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("X")}, saveObject: saveObject{err: nil}},
 			},
 			in:         inputParam{},
 			mustReturn: nil,
@@ -342,7 +350,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{},
@@ -367,6 +375,7 @@ func Test_GetNewsTags(t *testing.T) {
 			repository: &Repositories{
 				NewsTagDataRepository: &MockNewsTagDataRepository{
 					getBulkNewsTags: getBulkNewsTags{
+						total: 10,
 						res: []presentation.GetNewsTagsResponse{
 							{
 								ID:   1,
@@ -383,7 +392,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -403,7 +412,8 @@ func Test_GetNewsTags(t *testing.T) {
 					Name: "C",
 				},
 			},
-			mustErr: false,
+			mustMeta: &presentation.PaginationMeta{Total: 10, Offset: 1, Count: 3, HasMore: true},
+			mustErr:  false,
 		},
 		{
 			name: "Success - No Pagination, With Filter",
@@ -426,7 +436,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -469,7 +479,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -513,7 +523,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -544,7 +554,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -575,7 +585,7 @@ func Test_GetNewsTags(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -593,11 +603,9 @@ func Test_GetNewsTags(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			ctx := gin.Context{Request: &http.Request{RequestURI: "ADAWD"}}
-
-			got, err := uc.GetNewsTags(&ctx, tc.in.paginationString, tc.in.filterString)
+			got, err := uc.GetNewsTags(context.Background(), tc.in.paginationString, tc.in.filterString, "")
 
-			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got.Data, tc.mustReturn) || (tc.mustMeta != nil && !reflect.DeepEqual(got.Meta, *tc.mustMeta)) {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
 					FunctionName: "Test_CreateSingleNews",
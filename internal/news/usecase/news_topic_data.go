@@ -1,37 +1,57 @@
 package usecase
 
 import (
-	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"context"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/pkg/urlutils"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
 )
 
-func (uc *Usecase) CreateNewsTopics(in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
-	return uc.repositories.CreateBulkNewsTopics(in)
-}
-func (uc *Usecase) DeleteNewsTopics(newsTopicID []int) (deletedID []int, err error) {
-	return uc.repositories.DeleteBulkNewsTopics(newsTopicID)
+func (uc *Usecase) CreateNewsTopics(ctx context.Context, in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
+	insertedID, err = uc.repositories.CreateBulkNewsTopics(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateCache(ctx, "CreateNewsTopics", CACHE_TAG_NEWS_TOPIC_LIST)
+	return insertedID, nil
 }
-func (uc *Usecase) UpdateNewsTopics(newNewsTopics []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
-	return uc.repositories.UpdateBulkNewsTopics(newNewsTopics)
+
+// DeleteNewsTopics evict the topic listing and every cached news showing deleted topic
+func (uc *Usecase) DeleteNewsTopics(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
+	deletedID, err = uc.repositories.DeleteBulkNewsTopics(ctx, newsTopicID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.invalidateCache(ctx, "DeleteNewsTopics", append(idCacheTags(CACHE_TAG_NEWS_TOPIC, newsTopicID), CACHE_TAG_NEWS_TOPIC_LIST)...)
+	return deletedID, nil
 }
-func (uc *Usecase) GetNewsTopics(ctx *gin.Context, paginationString, filterString string) (res []presentation.GetNewsTopicsResponse, err error) {
-	// Get From Redis First
-	var redisData []presentation.GetNewsTopicsResponse
-	err = uc.repositories.GetObject(ctx.Request.RequestURI, &redisData)
-	if err == nil {
-		return redisData, nil
+
+// UpdateNewsTopics evict the topic listing and every cached news showing updated topic
+func (uc *Usecase) UpdateNewsTopics(ctx context.Context, newNewsTopics []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
+	updatedID, err = uc.repositories.UpdateBulkNewsTopics(ctx, newNewsTopics)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]int, 0, len(newNewsTopics))
+	for _, item := range newNewsTopics {
+		id = append(id, item.ID)
 	}
 
+	uc.invalidateCache(ctx, "UpdateNewsTopics", append(idCacheTags(CACHE_TAG_NEWS_TOPIC, id), CACHE_TAG_NEWS_TOPIC_LIST)...)
+	return updatedID, nil
+}
+
+func (uc *Usecase) GetNewsTopics(ctx context.Context, paginationString, filterString, sortString string) (res presentation.GetNewsTopicsListResponse, err error) {
 	var pagination *presentation.Pagination
 	var newsFilter *presentation.NewsTopicFilter
 
 	if paginationString != "" {
 		err = urlutils.DecodeEncodedString(paginationString, &pagination)
 		if err != nil {
-			return nil, response.InternalError{
+			return res, response.InternalError{
 				Type:         "Usecase",
 				Name:         "News Data",
 				FunctionName: "GetNewsTopics",
@@ -44,7 +64,7 @@ func (uc *Usecase) GetNewsTopics(ctx *gin.Context, paginationString, filterStrin
 	if filterString != "" {
 		err = urlutils.DecodeEncodedString(filterString, &newsFilter)
 		if err != nil {
-			return nil, response.InternalError{
+			return res, response.InternalError{
 				Type:         "Usecase",
 				Name:         "News Data",
 				FunctionName: "GetNewsTopics",
@@ -54,27 +74,32 @@ func (uc *Usecase) GetNewsTopics(ctx *gin.Context, paginationString, filterStrin
 		}
 	}
 
-	res, err = uc.repositories.GetBulkNewsTopics(pagination, newsFilter)
+	cacheKey, err := buildCacheKey(CACHE_NAMESPACE_NEWS_TOPICS, pagination, newsFilter, sortString)
 	if err != nil {
-		return nil, response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetNewsTopics",
-			Description:  "Failed Store data to redis",
-			Trace:        err,
-		}.Error()
+		return res, err
 	}
 
-	// Save Result to Redis
-	err = uc.repositories.SaveObject(ctx.Request.RequestURI, res)
+	// Get From Redis First, then from Database
+	err = uc.cachedRead(ctx, "GetNewsTopics", cacheKey, &res, func(ctx context.Context) (interface{}, []string, error) {
+		items, total, err := uc.repositories.GetBulkNewsTopics(ctx, pagination, newsFilter, sortString)
+		if err != nil {
+			return nil, nil, response.InternalError{
+				Type:         "UC",
+				Name:         "News Data",
+				FunctionName: "GetNewsTopics",
+				Description:  "Failed running repository",
+				Trace:        err,
+			}.Error()
+		}
+
+		// Cached together with pagination metadata
+		return presentation.GetNewsTopicsListResponse{
+			Data: items,
+			Meta: presentation.NewPaginationMeta(pagination, len(items), total),
+		}, []string{CACHE_TAG_NEWS_TOPIC_LIST}, nil
+	})
 	if err != nil {
-		logger.Error(response.InternalError{
-			Type:         "UC",
-			Name:         "News Data",
-			FunctionName: "GetNewsTopics",
-			Description:  "Failed Store data to redis",
-			Trace:        err,
-		}.Error())
+		return res, err
 	}
 
 	return res, nil
@@ -1,6 +1,9 @@
 package usecase
 
-import "github.com/Mufidzz/bareksa-test/presentation"
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
 
 type MockNewsTopicDataRepository struct {
 	createBulkNewsTopics createBulkNewsTopics
@@ -15,8 +18,9 @@ type createBulkNewsTopics struct {
 }
 
 type getBulkNewsTopics struct {
-	res []presentation.GetNewsTopicsResponse
-	err error
+	res   []presentation.GetNewsTopicsResponse
+	total int64
+	err   error
 }
 
 type updateBulkNewsTopics struct {
@@ -29,15 +33,15 @@ type deleteBulkNewsTopics struct {
 	err       error
 }
 
-func (mntdr *MockNewsTopicDataRepository) CreateBulkNewsTopics(in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
+func (mntdr *MockNewsTopicDataRepository) CreateBulkNewsTopics(ctx context.Context, in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
 	return mntdr.createBulkNewsTopics.insertedID, mntdr.createBulkNewsTopics.err
 }
-func (mntdr *MockNewsTopicDataRepository) GetBulkNewsTopics(pagination *presentation.Pagination, filter *presentation.NewsTopicFilter) (res []presentation.GetNewsTopicsResponse, err error) {
-	return mntdr.getBulkNewsTopics.res, mntdr.getBulkNewsTopics.err
+func (mntdr *MockNewsTopicDataRepository) GetBulkNewsTopics(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsTopicFilter, sort string) (res []presentation.GetNewsTopicsResponse, total int64, err error) {
+	return mntdr.getBulkNewsTopics.res, mntdr.getBulkNewsTopics.total, mntdr.getBulkNewsTopics.err
 }
-func (mntdr *MockNewsTopicDataRepository) UpdateBulkNewsTopics(in []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
+func (mntdr *MockNewsTopicDataRepository) UpdateBulkNewsTopics(ctx context.Context, in []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
 	return mntdr.updateBulkNewsTopics.updatedID, mntdr.updateBulkNewsTopics.err
 }
-func (mntdr *MockNewsTopicDataRepository) DeleteBulkNewsTopics(newsTopicID []int) (deletedID []int, err error) {
+func (mntdr *MockNewsTopicDataRepository) DeleteBulkNewsTopics(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
 	return mntdr.deleteBulkNewsTopics.deletedID, mntdr.deleteBulkNewsTopics.err
 }
@@ -1,11 +1,10 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/presentation"
-	"github.com/gin-gonic/gin"
-	"net/http"
 	"reflect"
 	"testing"
 )
@@ -25,6 +24,7 @@ func Test_CreateTopics(t *testing.T) {
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					createBulkNewsTopics: createBulkNewsTopics{
 						err: fmt.Errorf("ASD"),
@@ -47,6 +47,7 @@ func Test_CreateTopics(t *testing.T) {
 		{
 			name: "Success - Repo return no error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					createBulkNewsTopics: createBulkNewsTopics{
 						insertedID: []int{1, 2},
@@ -66,6 +67,26 @@ func Test_CreateTopics(t *testing.T) {
 			mustReturn: []int{1, 2},
 			mustErr:    false,
 		},
+		{
+			name: "Success - Cache invalidation error ignored",
+			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{fmt.Errorf("any")}},
+				NewsTopicDataRepository: &MockNewsTopicDataRepository{
+					createBulkNewsTopics: createBulkNewsTopics{
+						insertedID: []int{1},
+					},
+				},
+			},
+			in: inputParam{
+				in: []presentation.CreateNewsTopicsRequest{
+					{
+						Name: "AAA",
+					},
+				},
+			},
+			mustReturn: []int{1},
+			mustErr:    false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -74,7 +95,7 @@ func Test_CreateTopics(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			got, err := uc.CreateNewsTopics(tc.in.in)
+			got, err := uc.CreateNewsTopics(context.Background(), tc.in.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
@@ -103,6 +124,7 @@ func Test_DeleteNewsTopics(t *testing.T) {
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					deleteBulkNewsTopics: deleteBulkNewsTopics{
 						err: fmt.Errorf("ASD"),
@@ -118,6 +140,7 @@ func Test_DeleteNewsTopics(t *testing.T) {
 		{
 			name: "Success - Repo return no error, all deleted",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					deleteBulkNewsTopics: deleteBulkNewsTopics{
 						deletedID: []int{1, 2, 3, 4},
@@ -133,6 +156,7 @@ func Test_DeleteNewsTopics(t *testing.T) {
 		{
 			name: "Success - Repo return no error, partially deleted",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					deleteBulkNewsTopics: deleteBulkNewsTopics{
 						deletedID: []int{1, 2},
@@ -153,7 +177,7 @@ func Test_DeleteNewsTopics(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			got, err := uc.DeleteNewsTopics(tc.in.in)
+			got, err := uc.DeleteNewsTopics(context.Background(), tc.in.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
@@ -182,6 +206,7 @@ func Test_UpdateNewsTopics(t *testing.T) {
 		{
 			name: "Failed - Repo return error",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					updateBulkNewsTopics: updateBulkNewsTopics{
 						err: fmt.Errorf("ASD"),
@@ -213,6 +238,7 @@ func Test_UpdateNewsTopics(t *testing.T) {
 		{
 			name: "Success - Repo return no error, all updated",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					updateBulkNewsTopics: updateBulkNewsTopics{
 						updatedID: []int{1, 2, 3, 4},
@@ -244,6 +270,7 @@ func Test_UpdateNewsTopics(t *testing.T) {
 		{
 			name: "Success - Repo return no error, partially updated",
 			repository: &Repositories{
+				NewsRedisRepository: &MockNewsRedisRepository{},
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					updateBulkNewsTopics: updateBulkNewsTopics{
 						updatedID: []int{1, 2},
@@ -280,7 +307,7 @@ func Test_UpdateNewsTopics(t *testing.T) {
 				repositories: tc.repository,
 			}
 
-			got, err := uc.UpdateNewsTopics(tc.in.in)
+			got, err := uc.UpdateNewsTopics(context.Background(), tc.in.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
 				tt.Error(response.InternalTestError{
@@ -306,6 +333,7 @@ func Test_GetNewsTopics(t *testing.T) {
 		in         inputParam
 		mustErr    bool
 		mustReturn []presentation.GetNewsTopicsResponse
+		mustMeta   *presentation.PaginationMeta
 	}{
 		{
 			name: "Failed - Repo return error",
@@ -315,7 +343,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						err: fmt.Errorf("ASD"),
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 			in:         inputParam{},
 			mustReturn: nil,
@@ -342,7 +370,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{},
@@ -367,6 +395,7 @@ func Test_GetNewsTopics(t *testing.T) {
 			repository: &Repositories{
 				NewsTopicDataRepository: &MockNewsTopicDataRepository{
 					getBulkNewsTopics: getBulkNewsTopics{
+						total: 10,
 						res: []presentation.GetNewsTopicsResponse{
 							{
 								ID:   1,
@@ -383,7 +412,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -403,7 +432,8 @@ func Test_GetNewsTopics(t *testing.T) {
 					Name: "C",
 				},
 			},
-			mustErr: false,
+			mustMeta: &presentation.PaginationMeta{Total: 10, Offset: 1, Count: 3, HasMore: true},
+			mustErr:  false,
 		},
 		{
 			name: "Success - No Pagination, With Filter",
@@ -426,7 +456,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -469,7 +499,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -513,7 +543,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -544,7 +574,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("s")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -575,7 +605,7 @@ func Test_GetNewsTopics(t *testing.T) {
 						},
 					},
 				},
-				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{fmt.Errorf("awd")}, saveObject: saveObject{err: nil}},
+				NewsRedisRepository: &MockNewsRedisRepository{getObject: getObject{err: fmt.Errorf("awd")}, saveObject: saveObject{err: nil}},
 			},
 
 			in: inputParam{
@@ -592,10 +622,9 @@ func Test_GetNewsTopics(t *testing.T) {
 			uc := Usecase{
 				repositories: tc.repository,
 			}
-			ctx := gin.Context{Request: &http.Request{RequestURI: "ADAWD"}}
-			got, err := uc.GetNewsTopics(&ctx, tc.in.paginationString, tc.in.filterString)
+			got, err := uc.GetNewsTopics(context.Background(), tc.in.paginationString, tc.in.filterString, "")
 
-			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got, tc.mustReturn) {
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(got.Data, tc.mustReturn) || (tc.mustMeta != nil && !reflect.DeepEqual(got.Meta, *tc.mustMeta)) {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
 					FunctionName: "Test_CreateSingleNews",
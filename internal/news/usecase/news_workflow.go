@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"time"
+)
+
+type newsTransition struct {
+	from []int
+	to   int
+
+	// role is the lowest role allowed to make the transition, writer can only make it on news it created
+	role auth.Role
+}
+
+// newsTransitions is the editorial workflow. News is created as draft, submitted for review, approved and published.
+// Rejected news go back to draft, and unpublished news is archived until submitted again.
+// Deletion and restoration are not part of it, any news can be deleted and restored news get back its status
+var newsTransitions = map[string]newsTransition{
+	presentation.NEWS_TRANSITION_SUBMIT: {
+		from: []int{presentation.NEWS_STATUS_DRAFT, presentation.NEWS_STATUS_ARCHIVED},
+		to:   presentation.NEWS_STATUS_IN_REVIEW,
+		role: auth.ROLE_WRITER,
+	},
+	presentation.NEWS_TRANSITION_REJECT: {
+		from: []int{presentation.NEWS_STATUS_IN_REVIEW, presentation.NEWS_STATUS_APPROVED},
+		to:   presentation.NEWS_STATUS_DRAFT,
+		role: auth.ROLE_EDITOR,
+	},
+	presentation.NEWS_TRANSITION_APPROVE: {
+		from: []int{presentation.NEWS_STATUS_IN_REVIEW},
+		to:   presentation.NEWS_STATUS_APPROVED,
+		role: auth.ROLE_EDITOR,
+	},
+	presentation.NEWS_TRANSITION_PUBLISH: {
+		from: []int{presentation.NEWS_STATUS_APPROVED},
+		to:   presentation.NEWS_STATUS_PUBLISHED,
+		role: auth.ROLE_EDITOR,
+	},
+	presentation.NEWS_TRANSITION_UNPUBLISH: {
+		from: []int{presentation.NEWS_STATUS_PUBLISHED},
+		to:   presentation.NEWS_STATUS_ARCHIVED,
+		role: auth.ROLE_EDITOR,
+	},
+}
+
+// TransitionNews move news through the editorial workflow and record who made the transition.
+// Transition not allowed from current status return ErrIllegalTransition, and missing news ErrNewsNotFound
+func (uc *Usecase) TransitionNews(ctx context.Context, newsID int, transition string) error {
+	rule, ok := newsTransitions[transition]
+	if !ok {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "TransitionNews",
+			Description:  "Unknown transition " + transition,
+			Trace:        ErrIllegalTransition,
+		}.Error()
+	}
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if ok && !principal.Role.Allows(rule.role) {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "TransitionNews",
+			Description:  fmt.Sprintf("%s role can not %s news", principal.Role, transition),
+			Trace:        auth.ErrForbidden,
+		}.Error()
+	}
+
+	err := uc.authorizeNewsChange(ctx, "TransitionNews", newsID)
+	if err != nil {
+		return err
+	}
+
+	transitioned, err := uc.repositories.TransitionNewsStatus(ctx, newsID, transition, rule.from, rule.to, principal.Subject)
+	if err != nil {
+		return err
+	}
+
+	if !transitioned {
+		return uc.refusedTransitionError(ctx, newsID, transition)
+	}
+
+	uc.invalidateCache(ctx, "TransitionNews", CACHE_TAG_NEWS_LIST, fmt.Sprintf(CACHE_TAG_NEWS, newsID))
+
+	return nil
+}
+
+// refusedTransitionError tell why repository refused the transition, news is either missing, not in allowed status,
+// or published after its unpublish_at which would be undone by the next schedule run
+func (uc *Usecase) refusedTransitionError(ctx context.Context, newsID int, transition string) error {
+	// Reason is read from primary since the refusal was decided there
+	news, _, err := uc.repositories.GetBulkNews(dbutils.WithPrimaryRead(ctx), presentation.Pagination{
+		Offset: 0,
+		Count:  1,
+	}, &presentation.NewsFilter{NewsID: newsID, IncludeDeleted: true}, "")
+	if err != nil {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "TransitionNews",
+			Description:  "Failed reading news status",
+			Trace:        err,
+		}.Error()
+	}
+
+	if len(news) <= 0 {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "TransitionNews",
+			Description:  "Data Not Found",
+			Trace:        ErrNewsNotFound,
+		}.Error()
+	}
+
+	rule := newsTransitions[transition]
+	if rule.to == presentation.NEWS_STATUS_PUBLISHED && news[0].UnpublishAt != nil && !news[0].UnpublishAt.After(time.Now()) {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "TransitionNews",
+			Description:  "publication window ended, clear or move unpublish_at before publishing",
+			Trace:        ErrIllegalTransition,
+		}.Error()
+	}
+
+	return response.InternalError{
+		Type:         "UC",
+		Name:         "News Workflow",
+		FunctionName: "TransitionNews",
+		Description:  fmt.Sprintf("can not %s %s news", transition, presentation.NewsStatusName(news[0].Status)),
+		Trace:        ErrIllegalTransition,
+	}.Error()
+}
+
+// GetNewsTransitions return workflow history of news, oldest first
+func (uc *Usecase) GetNewsTransitions(ctx context.Context, newsID int) ([]presentation.NewsStatusTransition, error) {
+	res, err := uc.repositories.GetNewsStatusTransitions(ctx, newsID)
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "GetNewsTransitions",
+			Description:  "Failed running repository",
+			Trace:        err,
+		}.Error()
+	}
+
+	if res == nil {
+		res = []presentation.NewsStatusTransition{}
+	}
+
+	return res, nil
+}
+
+// checkStatusUnchanged refuse status change outside of the workflow, empty status or the current one are accepted
+func (uc *Usecase) checkStatusUnchanged(ctx context.Context, newsID, status int) error {
+	if status == 0 {
+		return nil
+	}
+
+	news, _, err := uc.repositories.GetBulkNews(dbutils.WithPrimaryRead(ctx), presentation.Pagination{
+		Offset: 0,
+		Count:  1,
+	}, &presentation.NewsFilter{NewsID: newsID}, "")
+	if err != nil {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "checkStatusUnchanged",
+			Description:  "Failed reading news status",
+			Trace:        err,
+		}.Error()
+	}
+
+	if len(news) > 0 && news[0].Status != status {
+		return response.InternalError{
+			Type:         "UC",
+			Name:         "News Workflow",
+			FunctionName: "checkStatusUnchanged",
+			Description:  "status is changed through workflow transitions, not news update",
+			Trace:        ErrIllegalTransition,
+		}.Error()
+	}
+
+	return nil
+}
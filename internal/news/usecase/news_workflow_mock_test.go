@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/presentation"
+)
+
+type MockNewsWorkflowRepository struct {
+	transitionNewsStatus     transitionNewsStatus
+	getNewsStatusTransitions getNewsStatusTransitions
+	transitionDueNews        map[string]transitionDueNews
+	tryLockNewsSchedule      tryLockNewsSchedule
+}
+
+type transitionNewsStatus struct {
+	transitioned bool
+	err          error
+}
+
+type transitionDueNews struct {
+	transitionedID []int
+	err            error
+}
+
+type tryLockNewsSchedule struct {
+	locked bool
+	err    error
+}
+
+type getNewsStatusTransitions struct {
+	res []presentation.NewsStatusTransition
+	err error
+}
+
+func (mnwr *MockNewsWorkflowRepository) TransitionNewsStatus(ctx context.Context, newsID int, transition string, from []int, to int, transitionedBy string) (bool, error) {
+	return mnwr.transitionNewsStatus.transitioned, mnwr.transitionNewsStatus.err
+}
+func (mnwr *MockNewsWorkflowRepository) GetNewsStatusTransitions(ctx context.Context, newsID int) ([]presentation.NewsStatusTransition, error) {
+	return mnwr.getNewsStatusTransitions.res, mnwr.getNewsStatusTransitions.err
+}
+func (mnwr *MockNewsWorkflowRepository) TransitionDueNews(ctx context.Context, schedule, transition string, from []int, to int, transitionedBy string) ([]int, error) {
+	return mnwr.transitionDueNews[schedule].transitionedID, mnwr.transitionDueNews[schedule].err
+}
+func (mnwr *MockNewsWorkflowRepository) TryLockNewsSchedule(ctx context.Context) (bool, error) {
+	return mnwr.tryLockNewsSchedule.locked, mnwr.tryLockNewsSchedule.err
+}
@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/auth"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_TransitionNews(t *testing.T) {
+	owner := "writer-1"
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		principal  *auth.Principal
+		transition string
+		mustErr    bool
+		mustErrIs  error
+	}{
+		{
+			name:       "Failed - Unknown Transition",
+			repository: &Repositories{},
+			transition: "destroy",
+			mustErr:    true,
+			mustErrIs:  ErrIllegalTransition,
+		},
+		{
+			name:       "Failed - Writer Approve",
+			repository: &Repositories{},
+			principal:  &auth.Principal{Subject: owner, Role: auth.ROLE_WRITER},
+			transition: presentation.NEWS_TRANSITION_APPROVE,
+			mustErr:    true,
+			mustErrIs:  auth.ErrForbidden,
+		},
+		{
+			name: "Failed - Writer Submit Other News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, CreatedBy: &owner}}},
+				},
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					transitionNewsStatus: transitionNewsStatus{transitioned: true},
+				},
+			},
+			principal:  &auth.Principal{Subject: "writer-2", Role: auth.ROLE_WRITER},
+			transition: presentation.NEWS_TRANSITION_SUBMIT,
+			mustErr:    true,
+			mustErrIs:  auth.ErrForbidden,
+		},
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					transitionNewsStatus: transitionNewsStatus{err: fmt.Errorf("AXDCZ")},
+				},
+			},
+			transition: presentation.NEWS_TRANSITION_PUBLISH,
+			mustErr:    true,
+		},
+		{
+			name: "Failed - News Not Found",
+			repository: &Repositories{
+				NewsDataRepository:     &MockNewsRepository{},
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{},
+			},
+			transition: presentation.NEWS_TRANSITION_PUBLISH,
+			mustErr:    true,
+			mustErrIs:  ErrNewsNotFound,
+		},
+		{
+			name: "Failed - Publish Draft",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, Status: presentation.NEWS_STATUS_DRAFT}}},
+				},
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{},
+			},
+			transition: presentation.NEWS_TRANSITION_PUBLISH,
+			mustErr:    true,
+			mustErrIs:  ErrIllegalTransition,
+		},
+		{
+			name: "Failed - Publish After Unpublish At",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, Status: presentation.NEWS_STATUS_APPROVED, UnpublishAt: &yesterday}}, mustPrimaryRead: true},
+				},
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{},
+			},
+			transition: presentation.NEWS_TRANSITION_PUBLISH,
+			mustErr:    true,
+			mustErrIs:  ErrIllegalTransition,
+		},
+		{
+			name: "Success - Writer Submit Own News",
+			repository: &Repositories{
+				NewsDataRepository: &MockNewsRepository{
+					getBulkNews: getBulkNews{res: []presentation.GetNewsResponse{{ID: 1, CreatedBy: &owner}}, mustPrimaryRead: true},
+				},
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					transitionNewsStatus: transitionNewsStatus{transitioned: true},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{},
+			},
+			principal:  &auth.Principal{Subject: owner, Role: auth.ROLE_WRITER},
+			transition: presentation.NEWS_TRANSITION_SUBMIT,
+			mustErr:    false,
+		},
+		{
+			name: "Success - Editor Publish",
+			repository: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					transitionNewsStatus: transitionNewsStatus{transitioned: true},
+				},
+				NewsRedisRepository: &MockNewsRedisRepository{invalidateTags: invalidateTags{fmt.Errorf("invalidate error is only logged")}},
+			},
+			principal:  &auth.Principal{Subject: "editor-1", Role: auth.ROLE_EDITOR},
+			transition: presentation.NEWS_TRANSITION_PUBLISH,
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			ctx := context.Background()
+			if tc.principal != nil {
+				ctx = auth.WithPrincipal(ctx, *tc.principal)
+			}
+
+			err := uc.TransitionNews(ctx, 1, tc.transition)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_TransitionNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, mustErrIs %v, err %v", tc.mustErr, tc.mustErrIs, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetNewsTransitions(t *testing.T) {
+	transitions := []presentation.NewsStatusTransition{
+		{ID: 1, NewsID: 1, Transition: presentation.NEWS_TRANSITION_SUBMIT, FromStatus: presentation.NEWS_STATUS_DRAFT, ToStatus: presentation.NEWS_STATUS_IN_REVIEW},
+	}
+
+	testcases := []struct {
+		name       string
+		repository *Repositories
+		mustReturn []presentation.NewsStatusTransition
+		mustErr    bool
+	}{
+		{
+			name: "Failed - Repo return error",
+			repository: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					getNewsStatusTransitions: getNewsStatusTransitions{err: fmt.Errorf("AXDCZ")},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - No Transition",
+			repository: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{},
+			},
+			mustReturn: []presentation.NewsStatusTransition{},
+		},
+		{
+			name: "Success",
+			repository: &Repositories{
+				NewsWorkflowRepository: &MockNewsWorkflowRepository{
+					getNewsStatusTransitions: getNewsStatusTransitions{res: transitions},
+				},
+			},
+			mustReturn: transitions,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			uc := Usecase{
+				repositories: tc.repository,
+			}
+
+			res, err := uc.GetNewsTransitions(context.Background(), 1)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetNewsTransitions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import "context"
+
+// MockTransactionRepository pass tx repositories to callback, and record whether the work was committed or rolled back
+type MockTransactionRepository struct {
+	tx         *Repositories
+	beginErr   error
+	committed  bool
+	rolledBack bool
+}
+
+func (mtr *MockTransactionRepository) WithTx(ctx context.Context, fn func(tx TxRepositories) error) error {
+	if mtr.beginErr != nil {
+		return mtr.beginErr
+	}
+
+	err := fn(mtr.tx)
+	if err != nil {
+		mtr.rolledBack = true
+		return err
+	}
+
+	mtr.committed = true
+	return nil
+}
@@ -0,0 +1,9 @@
+package memory
+
+import "time"
+
+// MEMORY_TIMEOUT_NEWS is soft TTL, object older than it is returned as stale
+const MEMORY_TIMEOUT_NEWS = time.Duration(30) * time.Second
+
+// MEMORY_STALE_TIMEOUT_NEWS is hard TTL, object is removed after it
+const MEMORY_STALE_TIMEOUT_NEWS = time.Duration(5) * time.Minute
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"container/list"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	key        string
+	value      []byte
+	tags       []string
+	freshUntil time.Time
+	expireAt   time.Time
+}
+
+// Memory is in-process cache with the same contract as Redis repository, bounded to maxEntries by evicting least recently used entry
+type Memory struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// recency keep most recently used entry in front
+	recency *list.List
+	tags    map[string]map[string]bool
+
+	stats cache.Stats
+	now   func() time.Time
+}
+
+func New(maxEntries int) *Memory {
+	return &Memory{
+		maxEntries: maxEntries,
+		entries:    map[string]*list.Element{},
+		recency:    list.New(),
+		tags:       map[string]map[string]bool{},
+		now:        time.Now,
+	}
+}
+
+// Stats return cache hit, miss and error counters since start
+func (m *Memory) Stats() cache.StatsSnapshot {
+	return m.stats.Snapshot()
+}
@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+)
+
+// GetObject read object saved by SaveObject into dest, stale is true when the object passed its soft TTL but not yet removed
+func (m *Memory) GetObject(ctx context.Context, key string, dest interface{}) (stale bool, err error) {
+	m.mu.Lock()
+	element, ok := m.entries[key]
+	if ok && m.now().After(element.Value.(*entry).expireAt) {
+		m.remove(element)
+		ok = false
+	}
+
+	if !ok {
+		m.mu.Unlock()
+		m.stats.Miss()
+		return false, cache.ErrCacheMiss
+	}
+
+	m.recency.MoveToFront(element)
+	e := element.Value.(*entry)
+	stale = m.now().After(e.freshUntil)
+	m.mu.Unlock()
+
+	// Saved value is never changed, so it is decoded outside the lock
+	err = json.Unmarshal(e.value, &dest)
+	if err != nil {
+		m.stats.Error()
+		return false, response.InternalError{
+			Type:         "Repo",
+			Name:         "Memory",
+			FunctionName: "GetObject",
+			Description:  "Failed Unmarshal JSON",
+			Trace:        fmt.Errorf("%w: %v", cache.ErrCacheCorrupt, err),
+		}.Error()
+	}
+
+	m.stats.Hit()
+	return stale, nil
+}
+
+// SaveObject store value as JSON under key, fresh for MEMORY_TIMEOUT_NEWS and kept as stale until MEMORY_STALE_TIMEOUT_NEWS.
+// Value is copied as JSON so later change by the caller does not reach the cache
+func (m *Memory) SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error {
+	valueJson, err := json.Marshal(value)
+	if err != nil {
+		m.stats.Error()
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Memory",
+			FunctionName: "SaveObject",
+			Description:  "Failed Marshall JSON",
+			Trace:        err,
+		}.Error()
+	}
+
+	now := m.now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if element, ok := m.entries[key]; ok {
+		m.remove(element)
+	}
+
+	m.entries[key] = m.recency.PushFront(&entry{
+		key:        key,
+		value:      valueJson,
+		tags:       tags,
+		freshUntil: now.Add(MEMORY_TIMEOUT_NEWS),
+		expireAt:   now.Add(MEMORY_STALE_TIMEOUT_NEWS),
+	})
+
+	for _, tag := range tags {
+		if m.tags[tag] == nil {
+			m.tags[tag] = map[string]bool{}
+		}
+		m.tags[tag][key] = true
+	}
+
+	for m.maxEntries > 0 && m.recency.Len() > m.maxEntries {
+		m.remove(m.recency.Back())
+	}
+
+	return nil
+}
+
+// InvalidateTags delete every object saved under any of the tags
+func (m *Memory) InvalidateTags(ctx context.Context, tags ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, tag := range tags {
+		for key := range m.tags[tag] {
+			if element, ok := m.entries[key]; ok {
+				m.remove(element)
+			}
+		}
+
+		delete(m.tags, tag)
+	}
+
+	return nil
+}
+
+// remove drop entry together with its tag links, m.mu must be held
+func (m *Memory) remove(element *list.Element) {
+	e := m.recency.Remove(element).(*entry)
+	delete(m.entries, e.key)
+
+	for _, tag := range e.tags {
+		delete(m.tags[tag], e.key)
+		if len(m.tags[tag]) == 0 {
+			delete(m.tags, tag)
+		}
+	}
+}
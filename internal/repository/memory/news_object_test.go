@@ -0,0 +1,151 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func Test_GetObject(t *testing.T) {
+	testcases := []struct {
+		name       string
+		saved      string
+		advance    time.Duration
+		mustStale  bool
+		mustErrIs  error
+		mustReturn string
+	}{
+		{
+			name:       "Success - Fresh",
+			saved:      "A",
+			mustReturn: "A",
+		},
+		{
+			name:       "Success - Stale",
+			saved:      "A",
+			advance:    MEMORY_TIMEOUT_NEWS + time.Second,
+			mustStale:  true,
+			mustReturn: "A",
+		},
+		{
+			name:      "Failed - Expired",
+			saved:     "A",
+			advance:   MEMORY_STALE_TIMEOUT_NEWS + time.Second,
+			mustErrIs: cache.ErrCacheMiss,
+		},
+		{
+			name:      "Failed - Miss",
+			mustErrIs: cache.ErrCacheMiss,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			now := time.Now()
+			m := New(10)
+			m.now = func() time.Time { return now }
+
+			if tc.saved != "" {
+				_ = m.SaveObject(context.Background(), "key", tc.saved)
+			}
+
+			now = now.Add(tc.advance)
+
+			var got string
+			stale, err := m.GetObject(context.Background(), "key", &got)
+
+			if got != tc.mustReturn || stale != tc.mustStale || (tc.mustErrIs == nil && err != nil) || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetObject",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v stale %v, expected %v stale %v, err %v", got, stale, tc.mustReturn, tc.mustStale, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_EvictAndInvalidate(t *testing.T) {
+	testcases := []struct {
+		name       string
+		maxEntries int
+		saved      map[string][]string
+		read       []string
+		invalidate []string
+		mustRemain []string
+	}{
+		{
+			name:       "Success - Least Recently Used Evicted",
+			maxEntries: 2,
+			saved:      map[string][]string{"a": nil, "b": nil},
+			read:       []string{"a"},
+			mustRemain: []string{"a", "c"},
+		},
+		{
+			name:       "Success - Only Tagged Keys Invalidated",
+			maxEntries: 10,
+			saved: map[string][]string{
+				"a": {"news:1", "news-topic:1"},
+				"b": {"news:2"},
+			},
+			invalidate: []string{"news-topic:1"},
+			mustRemain: []string{"b", "c"},
+		},
+		{
+			name:       "Success - Evicted Key Dropped From Tag",
+			maxEntries: 1,
+			saved:      map[string][]string{"a": {"news:1"}},
+			invalidate: []string{"news:1"},
+			mustRemain: []string{"c"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			ctx := context.Background()
+			m := New(tc.maxEntries)
+
+			keys := make([]string, 0, len(tc.saved))
+			for key := range tc.saved {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				_ = m.SaveObject(ctx, key, key, tc.saved[key]...)
+			}
+
+			var dest string
+			for _, key := range tc.read {
+				_, _ = m.GetObject(ctx, key, &dest)
+			}
+
+			// c is saved last without tag, it push out least recently used entry when full
+			_ = m.SaveObject(ctx, "c", "c")
+			_ = m.InvalidateTags(ctx, tc.invalidate...)
+
+			var remain []string
+			for _, key := range []string{"a", "b", "c"} {
+				if _, err := m.GetObject(ctx, key, &dest); err == nil {
+					remain = append(remain, key)
+				}
+			}
+
+			if !reflect.DeepEqual(remain, tc.mustRemain) || len(m.tags) > len(tc.saved) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_EvictAndInvalidate",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, tags %v", remain, tc.mustRemain, m.tags),
+				}.Error())
+			}
+		})
+	}
+}
@@ -1,4 +1,60 @@
 package postgre
 
+import "github.com/Mufidzz/bareksa-test/presentation"
+
 const DB_DRIVER_NAME_POSTGRE = "postgres"
 const DB_DRIVER_NAME_SQLMOCK = "sqlmock"
+
+// Sort parameter field to column whitelist per entity, anything else is rejected before reaching the query
+var NEWS_SORT_COLUMNS = map[string]string{
+	"id":         "news.id",
+	"created_at": "news.created_at",
+	"updated_at": "news.updated_at",
+	"title":      "news.title",
+	"status":     "news.status",
+}
+
+var NEWS_TOPIC_SORT_COLUMNS = map[string]string{
+	"id":   "id",
+	"name": "name",
+}
+
+var NEWS_TAG_SORT_COLUMNS = map[string]string{
+	"id":   "id",
+	"name": "name",
+}
+
+var NEWS_AUTHOR_SORT_COLUMNS = map[string]string{
+	"id":   "id",
+	"name": "name",
+}
+
+// Default sort when sort parameter is empty, the tie breaker keep it stable between pages
+const NEWS_DEFAULT_SORT = "-created_at"
+const NEWS_SORT_TIE_BREAKER = "-id"
+const NEWS_TOPIC_SORT_TIE_BREAKER = "id"
+const NEWS_TAG_SORT_TIE_BREAKER = "id"
+const NEWS_AUTHOR_SORT_TIE_BREAKER = "id"
+
+// NEWS_SEARCH_CONFIG must match text search config of news.search_vector generated column
+const NEWS_SEARCH_CONFIG = "simple"
+
+// ts_headline options, title is short so it is highlighted whole, content is cut into fragments around matched words
+const NEWS_SEARCH_TITLE_HIGHLIGHT = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+const NEWS_SEARCH_CONTENT_HIGHLIGHT = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10"
+
+// Schedule to column whitelist of TransitionDueNews
+var NEWS_SCHEDULE_COLUMNS = map[string]string{
+	presentation.NEWS_SCHEDULE_PUBLISH:   "publish_at",
+	presentation.NEWS_SCHEDULE_UNPUBLISH: "unpublish_at",
+}
+
+// NEWS_SCHEDULE_LOCK_KEY is advisory lock key held by the instance running news schedule, see TryLockNewsSchedule
+const NEWS_SCHEDULE_LOCK_KEY int64 = 0x6e657773
+
+// NEWS_REVISION_INSERT start insert of news snapshot, CreateBulkNews and UpdateBulkNews complete it with the written rows
+const NEWS_REVISION_INSERT = `INSERT INTO news_revisions (news_id, revision, title, content, status, publish_at, unpublish_at, restored_from, revised_by)`
+
+// NEWS_PUBLICATION_WINDOW_OPEN hold for news whose unpublish_at has not come. News published outside of it would be
+// unpublished again by the next schedule run, so transition to published require it
+const NEWS_PUBLICATION_WINDOW_OPEN = "(unpublish_at IS NULL OR unpublish_at > now())"
@@ -4,26 +4,34 @@ import (
 	"fmt"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"sync"
+	"time"
 )
 
 type Postgre struct {
 	// We can add multiple database connection by creating new struct here
 	// and add the connection string on initialization of postgre repository package
 	newsDatabase *PgDB
+
+	// tx is set on repository given to WithTx callback
+	tx *sqlx.Tx
 }
 
 func New(
 	newsDatabaseConnectionString string,
+	newsReplicaConnectionStrings []string,
+	readOptions ReadOptions,
 ) (*Postgre, error) {
 	var err error
 
-	newsDatabase, err := InitPostgreDB(newsDatabaseConnectionString, "")
+	newsDatabase, err := InitPostgreDB(newsDatabaseConnectionString, newsReplicaConnectionStrings...)
 	if err != nil {
 		return nil, fmt.Errorf("[Postgre][Init] Failed init user database, trace %v", err)
 	}
+	newsDatabase.ReadOptions = readOptions
 
 	return &Postgre{
-		newsDatabase: &newsDatabase,
+		newsDatabase: newsDatabase,
 	}, nil
 }
 
@@ -36,27 +44,45 @@ func NewWithDBObject(
 
 }
 
+type ReadOptions struct {
+	// SlaveRetryInterval is how long a failing slave skipped before it receives read again
+	SlaveRetryInterval time.Duration
+}
+
 type PgDB struct {
 	Master *sqlx.DB
 
-	// Slave added on case if we need to add slave to its database due to performance issue
-	Slave *sqlx.DB
+	// Slaves serve read only queries in round-robin, Master is used when there is no healthy slave
+	Slaves []*sqlx.DB
+
+	ReadOptions ReadOptions
+
+	readCounter uint32
+	slaveDownAt sync.Map
 }
 
 func InitPostgreDB(
 	masterDriver string,
-	slaveDriver string,
-) (db PgDB, err error) {
+	slaveDrivers ...string,
+) (db *PgDB, err error) {
+	db = &PgDB{}
+
 	db.Master, err = sqlx.Connect(DB_DRIVER_NAME_POSTGRE, masterDriver)
 	if err != nil {
 		return db, err
 	}
 
-	if slaveDriver != "" {
-		db.Slave, err = sqlx.Connect(DB_DRIVER_NAME_POSTGRE, slaveDriver)
+	for _, slaveDriver := range slaveDrivers {
+		if slaveDriver == "" {
+			continue
+		}
+
+		slave, err := sqlx.Connect(DB_DRIVER_NAME_POSTGRE, slaveDriver)
 		if err != nil {
 			return db, err
 		}
+
+		db.Slaves = append(db.Slaves, slave)
 	}
 
 	return db, err
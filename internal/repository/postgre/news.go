@@ -1,31 +1,34 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/Mufidzz/bareksa-test/presentation"
 	"github.com/lib/pq"
+	"time"
 )
 
-func (db *Postgre) CreateBulkNews(in []presentation.CreateNewsRequest) (insertedID []int, err error) {
-	q := `INSERT INTO news (title, content, status) VALUES`
+// CreateBulkNews insert news and record their content as first revision, see GetNewsRevisions
+func (db *Postgre) CreateBulkNews(ctx context.Context, in []presentation.CreateNewsRequest) (insertedID []int, err error) {
+	q := `INSERT INTO news (title, content, status, created_by, publish_at, unpublish_at) VALUES`
 
-	queryParamLen := 3
+	queryParamLen := 6
 
 	paramCount := 1
 	paramArgs := []interface{}{}
 
 	for _, v := range in {
-		q = fmt.Sprintf("%s ($%d, $%d, $%d),", q, paramCount, paramCount+1, paramCount+2)
-		paramArgs = append(paramArgs, v.Title, v.Content, v.Status)
+		q = fmt.Sprintf("%s ($%d, $%d, $%d, NULLIF($%d, ''), $%d, $%d),", q, paramCount, paramCount+1, paramCount+2, paramCount+3, paramCount+4, paramCount+5)
+		paramArgs = append(paramArgs, v.Title, v.Content, v.Status, v.CreatedBy, v.PublishAt, v.UnpublishAt)
 		paramCount += queryParamLen
 	}
 
-	// Remove Comma From end of line and Fetch ID after creation
-	q = fmt.Sprintf("%s RETURNING id", q[:len(q)-1])
+	// Remove Comma From end of line, record first revision and Fetch ID after creation
+	q = fmt.Sprintf(`WITH inserted AS (%s RETURNING id, revision, title, content, status, publish_at, unpublish_at, created_by), revised AS (%s SELECT id, revision, title, content, status, publish_at, unpublish_at, NULL::INT, created_by FROM inserted) SELECT id FROM inserted`, q[:len(q)-1], NEWS_REVISION_INSERT)
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -55,99 +58,310 @@ func (db *Postgre) CreateBulkNews(in []presentation.CreateNewsRequest) (inserted
 	return insertedID, nil
 }
 
-func (db *Postgre) GetBulkNews(pagination presentation.Pagination, filter *presentation.NewsFilter) (res []presentation.GetNewsResponse, err error) {
-	q := `SELECT news.id, news.created_at, news.updated_at, news.title, news.content, coalesce(string_agg(DISTINCT topics.name, ', '), '') as topics_name, coalesce(string_agg(DISTINCT tags.name, ', '),'') as tags_name, news.status FROM news
-			LEFT JOIN assoc_news_topics aTopics on news.id = aTopics.news_id
+// NEWS_LIST_COLUMNS select news with its topics and tags aggregated per row, and total of matching news regardless of pagination.
+// Authors are read by subquery instead of join so they keep byline order and do not multiply joined rows
+const NEWS_LIST_COLUMNS = `news.id, news.created_at, news.updated_at, news.title, news.content, coalesce(string_agg(DISTINCT topics.name, ', '), '') as topics_name, coalesce(string_agg(DISTINCT tags.name, ', '),'') as tags_name, news.status, news.deleted_at, news.created_by, news.publish_at, news.unpublish_at,
+			to_jsonb(array_remove(array_agg(DISTINCT CASE WHEN topics.id IS NOT NULL THEN jsonb_build_object('id', topics.id, 'name', topics.name) END), NULL)) as topics,
+			to_jsonb(array_remove(array_agg(DISTINCT CASE WHEN tags.id IS NOT NULL THEN jsonb_build_object('id', tags.id, 'name', tags.name) END), NULL)) as tags,
+			coalesce((SELECT jsonb_agg(jsonb_build_object('id', authors.id, 'name', authors.name) ORDER BY aAuthors.position, authors.id) FROM assoc_news_authors aAuthors JOIN authors ON aAuthors.author_id = authors.id WHERE aAuthors.news_id = news.id), '[]'::jsonb) as authors,
+			COUNT(*) OVER() as total_count`
+
+// NEWS_ASSOC_JOINS join topics and tags aggregated by NEWS_LIST_COLUMNS, query using it must group by news.id
+const NEWS_ASSOC_JOINS = `LEFT JOIN assoc_news_topics aTopics on news.id = aTopics.news_id
             LEFT JOIN news_topics topics on aTopics.news_topic_id = topics.id
             LEFT JOIN assoc_news_tags aTags on news.id = aTags.news_id
             LEFT JOIN news_tags tags on aTags.news_tag_id = tags.id`
 
-	paramCount := 0
-	paramArgs := []interface{}{}
+// GetBulkNews return news with its topics and tags newest first, and total of matching news regardless of pagination.
+// When pagination.Position is set, page is read by keyset on (created_at, id) from it and total count news from the cursor onward,
+// keyset need fixed order so sort is not applied on cursor pagination.
+func (db *Postgre) GetBulkNews(ctx context.Context, pagination presentation.Pagination, filter *presentation.NewsFilter, sort string) (res []presentation.GetNewsResponse, total int64, err error) {
+	q := fmt.Sprintf(`SELECT %s FROM news
+			%s`, NEWS_LIST_COLUMNS, NEWS_ASSOC_JOINS)
+
+	where := dbutils.And(newsFilterConditions(filter)...)
+
+	// Keyset Pagination, newest first. Previous page is read in ascending order then reversed
+	order := dbutils.SORT_DESC
+	cursor := pagination.Position
+	if cursor != nil {
+		comparator := dbutils.COMPARATOR_LESS
+		if cursor.Direction == presentation.CURSOR_DIRECTION_PREV {
+			comparator, order = dbutils.COMPARATOR_GREATER, dbutils.SORT_ASC
+		}
 
-	// Apply Filter if Available
-	if filter != nil {
-		if filter.NewsID != 0 {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "news.id", dbutils.COMPARATOR_EQUAL, paramCount)
-			paramArgs = append(paramArgs, filter.NewsID)
+		where.Add(dbutils.Raw(fmt.Sprintf("(news.created_at, news.id) %s (?, ?)", comparator), cursor.CreatedAt, cursor.ID))
+	}
+
+	whereClause, paramArgs := where.Where(0)
+	q = fmt.Sprintf("%s%s", q, whereClause)
+	paramCount := len(paramArgs)
+	countQuery, countArgs := fmt.Sprintf("SELECT news.id FROM news %s%s GROUP BY news.id", NEWS_ASSOC_JOINS, whereClause), paramArgs
+
+	// Implement Grouping
+	q = fmt.Sprintf("%s GROUP BY %s", q, "news.id")
+
+	// Implement Ordering
+	if cursor != nil {
+		q = fmt.Sprintf("%s ORDER BY news.created_at %s, news.id %s", q, order, order)
+	} else {
+		if sort == "" {
+			sort = NEWS_DEFAULT_SORT
 		}
 
-		if filter.Status != 0 {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "status", dbutils.COMPARATOR_EQUAL, paramCount)
-			paramArgs = append(paramArgs, filter.Status)
+		q, err = dbutils.AddSort(q, sort, NEWS_SORT_COLUMNS, NEWS_SORT_TIE_BREAKER)
+		if err != nil {
+			return nil, 0, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "GetBulkNews",
+				Description:  "invalid sort",
+				Trace:        err,
+			}.Error()
 		}
+	}
+
+	// Implement Pagination, cursor already point to page start so offset is not used
+	if cursor != nil {
+		q = fmt.Sprintf("%s LIMIT $%d", q, paramCount+1)
+		paramArgs = append(paramArgs, pagination.Count)
+	} else {
+		q = fmt.Sprintf("%s LIMIT $%d OFFSET $%d", q, paramCount+1, paramCount+2)
+		paramArgs = append(paramArgs, pagination.Count, pagination.Offset)
+	}
+
+	rows, err := db.queryRead(ctx, "GetBulkNews", q, paramArgs...)
+	if err != nil {
+		return nil, 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetBulkNews",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
 
-		if filter.Topics != nil && len(filter.Topics) > 0 {
-			paramCount += 1
-			q = dbutils.AddCustomFilter(q, dbutils.CONNECTOR_AND, "topics.id", dbutils.COMPARATOR_EQUAL, fmt.Sprintf("ANY($%d)", paramCount))
-			paramArgs = append(paramArgs, pq.Array(filter.Topics))
+	for rows.Next() {
+		var _t struct {
+			presentation.GetNewsResponse
+			TotalCount int64 `db:"total_count"`
 		}
 
-		if filter.Title != "" {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "news.title", dbutils.COMPARATOR_LIKE, paramCount)
-			paramArgs = append(paramArgs, fmt.Sprintf("%%%s%%", filter.Title))
+		err = rows.StructScan(&_t)
+		if err != nil {
+			return nil, 0, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "GetBulkNews",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
 		}
+
+		res = append(res, _t.GetNewsResponse)
+		total = _t.TotalCount
 	}
 
-	// Implement Grouping
-	q = fmt.Sprintf("%s GROUP BY %s", q, "news.id")
+	// Total is carried by returned rows, page past the last row count matching news on its own
+	if len(res) == 0 && cursor == nil && pagination.Offset > 0 {
+		total, err = db.countRows(ctx, "GetBulkNews", countQuery, countArgs...)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if order == dbutils.SORT_ASC {
+		for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+			res[i], res[j] = res[j], res[i]
+		}
+	}
 
-	// Implement Pagination
-	q = fmt.Sprintf("%s LIMIT $%d OFFSET $%d", q, paramCount+1, paramCount+2)
+	return res, total, nil
+}
+
+// SearchNews return news matching full-text query on title and content ranked by relevance, with matched words highlighted.
+// Query use websearch syntax (quoted phrase, OR, -exclude) and can be combined with NewsFilter, cursor pagination is not supported
+func (db *Postgre) SearchNews(ctx context.Context, query string, pagination presentation.Pagination, filter *presentation.NewsFilter) (res []presentation.SearchNewsResponse, total int64, err error) {
+	if pagination.Cursor != "" {
+		return nil, 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "SearchNews",
+			Description:  "cursor pagination is not supported on search",
+			Trace:        pagination.Cursor,
+		}.Error()
+	}
+
+	tsQuery := fmt.Sprintf("websearch_to_tsquery('%s', $1)", NEWS_SEARCH_CONFIG)
+
+	q := fmt.Sprintf(`SELECT %s,
+			ts_rank(news.search_vector, %s) as rank,
+			ts_headline('%s', news.title, %s, '%s') as title_highlight,
+			ts_headline('%s', news.content, %s, '%s') as content_highlight FROM news
+			%s`,
+		NEWS_LIST_COLUMNS,
+		tsQuery,
+		NEWS_SEARCH_CONFIG, tsQuery, NEWS_SEARCH_TITLE_HIGHLIGHT,
+		NEWS_SEARCH_CONFIG, tsQuery, NEWS_SEARCH_CONTENT_HIGHLIGHT,
+		NEWS_ASSOC_JOINS)
+
+	// $1 is the search query, already used by select columns above
+	where := dbutils.And(dbutils.Raw(fmt.Sprintf("news.search_vector @@ %s", tsQuery))).Add(newsFilterConditions(filter)...)
+
+	whereClause, filterArgs := where.Where(1)
+	q = fmt.Sprintf("%s%s", q, whereClause)
+	paramArgs := append([]interface{}{query}, filterArgs...)
+	paramCount := len(paramArgs)
+	countQuery, countArgs := fmt.Sprintf("SELECT news.id FROM news %s%s GROUP BY news.id", NEWS_ASSOC_JOINS, whereClause), paramArgs
+
+	// Implement Grouping, Ordering and Pagination
+	q = fmt.Sprintf("%s GROUP BY news.id ORDER BY rank DESC, news.id DESC LIMIT $%d OFFSET $%d", q, paramCount+1, paramCount+2)
 	paramArgs = append(paramArgs, pagination.Count, pagination.Offset)
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.queryRead(ctx, "SearchNews", q, paramArgs...)
 	if err != nil {
-		return nil, response.InternalError{
+		return nil, 0, response.InternalError{
 			Type:         "Repo",
 			Name:         "Postgre",
-			FunctionName: "GetBulkNews",
+			FunctionName: "SearchNews",
 			Description:  "failed running queryx",
 			Trace:        err,
 		}.Error()
 	}
 
 	for rows.Next() {
-		var _t presentation.GetNewsResponse
+		var _t struct {
+			presentation.SearchNewsResponse
+			TotalCount int64 `db:"total_count"`
+		}
 
 		err = rows.StructScan(&_t)
 		if err != nil {
-			return nil, response.InternalError{
+			return nil, 0, response.InternalError{
 				Type:         "Repo",
 				Name:         "Postgre",
-				FunctionName: "GetBulkNews",
+				FunctionName: "SearchNews",
 				Description:  "failed scan",
 				Trace:        err,
 			}.Error()
 		}
 
-		res = append(res, _t)
+		res = append(res, _t.SearchNewsResponse)
+		total = _t.TotalCount
 	}
 
-	return res, nil
+	if len(res) == 0 && pagination.Offset > 0 {
+		total, err = db.countRows(ctx, "SearchNews", countQuery, countArgs...)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return res, total, nil
 }
 
-func (db *Postgre) UpdateBulkNews(in []presentation.UpdateNewsRequest) (updatedID []int, err error) {
-	q := `UPDATE news SET title = new_news.title, content = new_news.content, status = new_news.status, updated_at = now() FROM (VALUES %s) as new_news (id, title, content, status) WHERE news.id = new_news.id RETURNING news.id`
+// newsFilterConditions build NewsFilter conditions shared by news listing and search, deleted news is hidden unless explicitly requested
+func newsFilterConditions(filter *presentation.NewsFilter) (conditions []dbutils.Condition) {
+	// Apply Filter if Available
+	if filter != nil {
+		if filter.NewsID != 0 {
+			conditions = append(conditions, dbutils.Equal("news.id", filter.NewsID))
+		}
 
-	queryParamLen := 4
+		if len(filter.NewsIDs) > 0 {
+			conditions = append(conditions, dbutils.Any("news.id", filter.NewsIDs))
+		}
+
+		if filter.Status != 0 {
+			conditions = append(conditions, dbutils.Equal("news.status", filter.Status))
+		}
+
+		if len(filter.Statuses) > 0 {
+			conditions = append(conditions, dbutils.Any("news.status", filter.Statuses))
+		}
+
+		if len(filter.Topics) > 0 {
+			conditions = append(conditions, assocCondition("assoc_news_topics", "news_topic_id", filter.Topics, filter.TopicsMatch))
+		}
+
+		if len(filter.Tags) > 0 {
+			conditions = append(conditions, assocCondition("assoc_news_tags", "news_tag_id", filter.Tags, filter.TagsMatch))
+		}
+
+		if len(filter.Authors) > 0 {
+			conditions = append(conditions, assocCondition("assoc_news_authors", "author_id", filter.Authors, filter.AuthorsMatch))
+		}
+
+		if filter.Title != "" {
+			conditions = append(conditions, dbutils.Like("news.title", fmt.Sprintf("%%%s%%", filter.Title)))
+		}
+
+		conditions = append(conditions,
+			timeRangeCondition("news.created_at", filter.CreatedFrom, filter.CreatedTo),
+			timeRangeCondition("news.updated_at", filter.UpdatedFrom, filter.UpdatedTo),
+		)
+	}
+
+	if filter != nil && filter.Live {
+		conditions = append(conditions,
+			dbutils.Equal("news.status", presentation.NEWS_STATUS_PUBLISHED),
+			dbutils.Raw("(news.publish_at IS NULL OR news.publish_at <= now())"),
+			dbutils.Raw("(news.unpublish_at IS NULL OR news.unpublish_at > now())"),
+		)
+	}
+
+	if !filter.ShowDeleted() {
+		conditions = append(conditions, dbutils.IsNull("news.deleted_at"))
+	}
+
+	return conditions
+}
+
+// assocCondition match news associated with any of ids, or with every one of ids when match is FILTER_MATCH_ALL.
+// It check assocTable in subquery instead of the joined rows, so topics, tags and authors aggregated for matching news stay complete
+func assocCondition(assocTable, assocColumn string, ids []int, match string) dbutils.Condition {
+	if match == presentation.FILTER_MATCH_ALL {
+		// No requested id is missing from the news association
+		return dbutils.Raw(fmt.Sprintf(`NOT EXISTS (SELECT 1 FROM unnest(?::INTEGER[]) AS requested (id) WHERE NOT EXISTS (SELECT 1 FROM %s fa WHERE fa.news_id = news.id AND fa.%s = requested.id))`, assocTable, assocColumn), pq.Array(ids))
+	}
+
+	return dbutils.Raw(fmt.Sprintf(`EXISTS (SELECT 1 FROM %s fa WHERE fa.news_id = news.id AND fa.%s = ANY(?))`, assocTable, assocColumn), pq.Array(ids))
+}
+
+// timeRangeCondition match column inclusively between from and to, nil end is left open and nil condition returned when both are nil
+func timeRangeCondition(column string, from, to *time.Time) dbutils.Condition {
+	switch {
+	case from != nil && to != nil:
+		return dbutils.Between(column, *from, *to)
+	case from != nil:
+		return dbutils.GreaterOrEqual(column, *from)
+	case to != nil:
+		return dbutils.LessOrEqual(column, *to)
+	}
+
+	return nil
+}
+
+// UpdateBulkNews overwrite title, content and publication window, and record the result as new revision.
+// Status is only changed by TransitionNewsStatus, and deleted news is not updated
+func (db *Postgre) UpdateBulkNews(ctx context.Context, in []presentation.UpdateNewsRequest) (updatedID []int, err error) {
+	q := `WITH updated AS (UPDATE news SET title = new_news.title, content = new_news.content, publish_at = new_news.publish_at, unpublish_at = new_news.unpublish_at, revision = news.revision + 1, updated_at = now() FROM (VALUES %s) as new_news (id, title, content, publish_at, unpublish_at, updated_by, restored_from) WHERE news.id = new_news.id AND news.deleted_at IS NULL RETURNING news.id, news.revision, news.title, news.content, news.status, news.publish_at, news.unpublish_at, new_news.restored_from, new_news.updated_by), revised AS (%s SELECT id, revision, title, content, status, publish_at, unpublish_at, NULLIF(restored_from, 0), NULLIF(updated_by, '') FROM updated) SELECT id FROM updated`
+
+	queryParamLen := 7
 
 	queryValues := ""
 	paramCount := 1
 	paramArgs := []interface{}{}
 
 	for _, v := range in {
-		queryValues = fmt.Sprintf("%s($%d::BIGINT, $%d::TEXT, $%d::TEXT, $%d::INTEGER),", queryValues, paramCount, paramCount+1, paramCount+2, paramCount+3)
-		paramArgs = append(paramArgs, v.ID, v.Title, v.Content, v.Status)
+		queryValues = fmt.Sprintf("%s($%d::BIGINT, $%d::TEXT, $%d::TEXT, $%d::TIMESTAMPTZ, $%d::TIMESTAMPTZ, $%d::TEXT, $%d::INT),", queryValues, paramCount, paramCount+1, paramCount+2, paramCount+3, paramCount+4, paramCount+5, paramCount+6)
+		paramArgs = append(paramArgs, v.ID, v.Title, v.Content, v.PublishAt, v.UnpublishAt, v.UpdatedBy, v.RestoredFrom)
 		paramCount += queryParamLen
 	}
 
-	q = fmt.Sprintf(q, queryValues[:len(queryValues)-1])
+	q = fmt.Sprintf(q, queryValues[:len(queryValues)-1], NEWS_REVISION_INSERT)
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -177,15 +391,64 @@ func (db *Postgre) UpdateBulkNews(in []presentation.UpdateNewsRequest) (updatedI
 	return updatedID, nil
 }
 
-func (db *Postgre) DeleteBulkNews(newsID []int) (deletedID []int, err error) {
-	q := `DELETE FROM news WHERE id = ANY($1) RETURNING id`
+// DeleteBulkNews soft delete news, the row and its topic/tag associations are kept until PurgeDeletedNews
+func (db *Postgre) DeleteBulkNews(ctx context.Context, newsID []int) (deletedID []int, err error) {
+	q := `UPDATE news SET status_before_delete = status, status = $1, deleted_at = now(), updated_at = now() WHERE id = ANY($2) AND deleted_at IS NULL RETURNING id`
+
+	return db.execReturningNewsID(ctx, "DeleteBulkNews", q, presentation.NEWS_STATUS_DELETED, pq.Array(newsID))
+}
+
+// RestoreBulkNews undo DeleteBulkNews, news get back the status it had before deletion
+func (db *Postgre) RestoreBulkNews(ctx context.Context, newsID []int) (restoredID []int, err error) {
+	q := `UPDATE news SET status = coalesce(status_before_delete, $1), status_before_delete = NULL, deleted_at = NULL, updated_at = now() WHERE id = ANY($2) AND deleted_at IS NOT NULL RETURNING id`
+
+	return db.execReturningNewsID(ctx, "RestoreBulkNews", q, presentation.NEWS_STATUS_DRAFT, pq.Array(newsID))
+}
+
+// PurgeDeletedNews hard delete news soft deleted before deletedBefore, associations are removed by foreign key cascade
+func (db *Postgre) PurgeDeletedNews(ctx context.Context, deletedBefore time.Time) (purgedID []int, err error) {
+	q := `DELETE FROM news WHERE deleted_at IS NOT NULL AND deleted_at < $1 RETURNING id`
+
+	return db.execReturningNewsID(ctx, "PurgeDeletedNews", q, deletedBefore)
+}
+
+// countRows return number of rows q return. List queries take their total from COUNT(*) OVER() of returned rows,
+// so page past the last row use it to still report the total
+func (db *Postgre) countRows(ctx context.Context, functionName, q string, args ...interface{}) (total int64, err error) {
+	rows, err := db.queryRead(ctx, functionName, fmt.Sprintf("SELECT COUNT(*) FROM (%s) matched", q), args...)
+	if err != nil {
+		return 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: functionName,
+			Description:  "failed running count query",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		err = rows.Scan(&total)
+		if err != nil {
+			return 0, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: functionName,
+				Description:  "failed scan count",
+				Trace:        err,
+			}.Error()
+		}
+	}
+
+	return total, nil
+}
 
-	rows, err := db.newsDatabase.Master.Queryx(q, pq.Array(newsID))
+func (db *Postgre) execReturningNewsID(ctx context.Context, functionName, q string, args ...interface{}) (res []int, err error) {
+	rows, err := db.writer().QueryxContext(ctx, q, args...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
 			Name:         "Postgre",
-			FunctionName: "DeleteBulkNews",
+			FunctionName: functionName,
 			Description:  "failed running queryx",
 			Trace:        err,
 		}.Error()
@@ -198,14 +461,14 @@ func (db *Postgre) DeleteBulkNews(newsID []int) (deletedID []int, err error) {
 			return nil, response.InternalError{
 				Type:         "Repo",
 				Name:         "Postgre",
-				FunctionName: "DeleteBulkNews",
+				FunctionName: functionName,
 				Description:  "failed scan",
 				Trace:        err,
 			}.Error()
 		}
 
-		deletedID = append(deletedID, id)
+		res = append(res, id)
 	}
 
-	return deletedID, nil
+	return res, nil
 }
@@ -0,0 +1,299 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/lib/pq"
+)
+
+func (db *Postgre) CreateBulkNewsAuthors(ctx context.Context, in []presentation.CreateNewsAuthorsRequest) (insertedID []int, err error) {
+	q := `INSERT INTO authors (name) VALUES`
+
+	queryParamLen := 1
+
+	paramCount := 1
+	paramArgs := []interface{}{}
+
+	for _, v := range in {
+		q = fmt.Sprintf("%s ($%d),", q, paramCount)
+		paramArgs = append(paramArgs, v.Name)
+		paramCount += queryParamLen
+	}
+
+	// Remove Comma From end of line and Fetch ID after creation
+	q = fmt.Sprintf("%s RETURNING id", q[:len(q)-1])
+
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "CreateBulkNewsAuthors",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "CreateBulkNewsAuthors",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
+		}
+
+		insertedID = append(insertedID, id)
+	}
+
+	return insertedID, nil
+}
+
+func (db *Postgre) GetBulkNewsAuthors(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsAuthorsFilter, sort string) (res []presentation.GetNewsAuthorsResponse, total int64, err error) {
+	q := `SELECT id, name, COUNT(*) OVER() as total_count FROM authors `
+
+	where := dbutils.And()
+
+	// Apply Filter if Available
+	if filter != nil {
+		if filter.NewsAuthorID != 0 {
+			where.Add(dbutils.Equal("id", filter.NewsAuthorID))
+		}
+
+		if filter.Name != "" {
+			where.Add(dbutils.Like("name", fmt.Sprintf("%%%s%%", filter.Name)))
+		}
+	}
+
+	whereClause, paramArgs := where.Where(0)
+	q = fmt.Sprintf("%s%s", q, whereClause)
+	paramCount := len(paramArgs)
+	countQuery, countArgs := fmt.Sprintf("SELECT id FROM authors%s", whereClause), paramArgs
+
+	// Implement Ordering, sort on id when not requested
+	q, err = dbutils.AddSort(q, sort, NEWS_AUTHOR_SORT_COLUMNS, NEWS_AUTHOR_SORT_TIE_BREAKER)
+	if err != nil {
+		return nil, 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetBulkNewsAuthors",
+			Description:  "invalid sort",
+			Trace:        err,
+		}.Error()
+	}
+
+	// Implement Pagination if Any
+	if pagination != nil {
+		q = fmt.Sprintf("%s LIMIT $%d OFFSET $%d", q, paramCount+1, paramCount+2)
+		paramArgs = append(paramArgs, pagination.Count, pagination.Offset)
+	}
+
+	rows, err := db.queryRead(ctx, "GetBulkNewsAuthors", q, paramArgs...)
+	if err != nil {
+		return nil, 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetBulkNewsAuthors",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		var _t struct {
+			presentation.GetNewsAuthorsResponse
+			TotalCount int64 `db:"total_count"`
+		}
+
+		err = rows.StructScan(&_t)
+		if err != nil {
+			return nil, 0, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "GetBulkNewsAuthors",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
+		}
+
+		res = append(res, _t.GetNewsAuthorsResponse)
+		total = _t.TotalCount
+	}
+
+	// Page past the last row carry no window total
+	if len(res) == 0 && pagination != nil && pagination.Offset > 0 {
+		total, err = db.countRows(ctx, "GetBulkNewsAuthors", countQuery, countArgs...)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return res, total, nil
+}
+
+func (db *Postgre) UpdateBulkNewsAuthors(ctx context.Context, in []presentation.UpdateNewsAuthorsRequest) (updatedID []int, err error) {
+	q := `UPDATE authors SET name = new_values.name FROM (VALUES %s) as new_values (id, name) WHERE authors.id = new_values.id RETURNING authors.id`
+
+	queryParamLen := 2
+
+	queryValues := ""
+	paramCount := 1
+	paramArgs := []interface{}{}
+
+	for _, v := range in {
+		queryValues = fmt.Sprintf("%s($%d::BIGINT, $%d::TEXT),", queryValues, paramCount, paramCount+1)
+		paramArgs = append(paramArgs, v.ID, v.Name)
+		paramCount += queryParamLen
+	}
+
+	q = fmt.Sprintf(q, queryValues[:len(queryValues)-1])
+
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "UpdateBulkNewsAuthors",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "UpdateBulkNewsAuthors",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
+		}
+
+		updatedID = append(updatedID, id)
+	}
+
+	return updatedID, nil
+}
+
+func (db *Postgre) DeleteBulkNewsAuthors(ctx context.Context, newsAuthorID []int) (deletedID []int, err error) {
+	q := `DELETE FROM authors WHERE id = ANY($1) RETURNING id`
+
+	rows, err := db.writer().QueryxContext(ctx, q, pq.Array(newsAuthorID))
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "DeleteBulkNewsAuthors",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "DeleteBulkNewsAuthors",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
+		}
+
+		deletedID = append(deletedID, id)
+	}
+
+	return deletedID, nil
+}
+
+// CreateBulkNewsAuthorsAssoc append authors to each news byline in the given order, after authors the news already has
+func (db *Postgre) CreateBulkNewsAuthorsAssoc(ctx context.Context, in []presentation.CreateNewsAuthorsAssoc) (err error) {
+	q := `INSERT INTO assoc_news_authors (news_id, author_id, position) SELECT new_values.news_id, new_values.author_id, coalesce((SELECT max(position) + 1 FROM assoc_news_authors current WHERE current.news_id = new_values.news_id), 0) + new_values.position FROM (VALUES %s) as new_values (news_id, author_id, position)`
+
+	queryParamLen := 3
+
+	queryValues := ""
+	paramCount := 1
+	paramArgs := []interface{}{}
+
+	dataCount := 0
+
+	for _, v := range in {
+		dataCount += len(v.NewsAuthorID)
+		for position, newsAuthorID := range v.NewsAuthorID {
+			queryValues = fmt.Sprintf("%s($%d::INT, $%d::INT, $%d::INT),", queryValues, paramCount, paramCount+1, paramCount+2)
+			paramArgs = append(paramArgs, v.NewsID, newsAuthorID, position)
+			paramCount += queryParamLen
+		}
+	}
+
+	// Nothing to append, empty VALUES is not valid SQL
+	if dataCount == 0 {
+		return nil
+	}
+
+	q = fmt.Sprintf(q, queryValues[:len(queryValues)-1])
+
+	res, err := db.writer().ExecContext(ctx, q, paramArgs...)
+	if err != nil {
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "CreateBulkNewsAuthorsAssoc",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "CreateBulkNewsAuthorsAssoc",
+			Description:  "failed get number of rows affected",
+			Trace:        err,
+		}.Error()
+	}
+
+	if rowsAffected != int64(dataCount) {
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "CreateBulkNewsAuthorsAssoc",
+			Description:  "not all data inserted",
+			Trace:        fmt.Errorf("affected rows : %v, data count : %v", rowsAffected, dataCount),
+		}.Error()
+	}
+
+	return nil
+}
+
+func (db *Postgre) CleanNewsAuthorsAssoc(ctx context.Context, newsID []int) (err error) {
+	q := `DELETE FROM assoc_news_authors WHERE news_id = ANY($1)`
+
+	_, err = db.writer().ExecContext(ctx, q, pq.Array(newsID))
+	if err != nil {
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "CleanNewsAuthorsAssoc",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	return nil
+}
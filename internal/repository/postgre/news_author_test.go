@@ -0,0 +1,544 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"reflect"
+	"testing"
+)
+
+func Test_CreateBulkNewsAuthors(t *testing.T) {
+	pgDB, db, mock, err := initDB()
+	if err != nil {
+		t.Fatalf("Failed init Mock Database")
+	}
+	defer db.Close()
+
+	testcase := []struct {
+		name       string
+		in         []presentation.CreateNewsAuthorsRequest
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustReturn []int
+		mustErr    bool
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("INSERT INTO authors (.+) VALUES (.+) RETURNING id").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			in: []presentation.CreateNewsAuthorsRequest{
+				{Name: "A"},
+				{Name: "B"},
+				{Name: "C"},
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Success Create New Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id"}).
+					AddRow(1).
+					AddRow(2)
+
+				mm.ExpectQuery("INSERT INTO authors (.+) VALUES (.+) RETURNING id").
+					WillReturnRows(rows)
+			},
+			in: []presentation.CreateNewsAuthorsRequest{
+				{Name: "A"},
+				{Name: "B"},
+			},
+			mustReturn: []int{1, 2},
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mockExp(mock)
+			res, err := pgDB.CreateBulkNewsAuthors(context.Background(), tc.in)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateBulkNewsAuthors",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+
+}
+
+func Test_GetBulkNewsAuthors(t *testing.T) {
+	pgDB, db, mock, err := initDB()
+	if err != nil {
+		t.Fatalf("Failed init Mock Database")
+	}
+	defer db.Close()
+
+	testcase := []struct {
+		name       string
+		filter     *presentation.NewsAuthorsFilter
+		pagination *presentation.Pagination
+		sort       string
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustReturn []presentation.GetNewsAuthorsResponse
+		mustTotal  int64
+		mustErr    bool
+	}{
+		{
+			name:       "Failed - Sort Field Not Allowed",
+			mockExp:    func(mm sqlmock.Sqlmock) {},
+			sort:       "created_at",
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Sorted By Name Descending",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow(2, "B").
+					AddRow(1, "A")
+
+				mm.ExpectQuery(`SELECT (.+) FROM authors ORDER BY name DESC, id ASC$`).
+					WillReturnRows(rows)
+			},
+			sort: "-name",
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{ID: 2, Name: "B"},
+				{ID: 1, Name: "A"},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success - Offset Past Last Page Count Separately",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM authors ORDER BY id ASC LIMIT \$1 OFFSET \$2$`).
+					WithArgs(int64(10), int64(20)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "total_count"}))
+
+				mm.ExpectQuery(`SELECT COUNT\(\*\) FROM \(SELECT id FROM authors\) matched`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+			},
+			pagination: &presentation.Pagination{Offset: 20, Count: 10},
+			mustReturn: nil,
+			mustTotal:  12,
+			mustErr:    false,
+		},
+		{
+			name: "Failed - Count Query Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM authors ORDER BY id ASC LIMIT \$1 OFFSET \$2$`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "total_count"}))
+
+				mm.ExpectQuery(`SELECT COUNT\(\*\) FROM`).
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			pagination: &presentation.Pagination{Offset: 20, Count: 10},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM authors").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			filter:     nil,
+			pagination: nil,
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success #1 - No Filter, No Pagination",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow(1, "AVC").
+					AddRow(2, "AVC")
+
+				mm.ExpectQuery("SELECT (.+) FROM authors").
+					WillReturnRows(rows)
+			},
+			filter:     nil,
+			pagination: nil,
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{ID: 1, Name: "AVC"},
+				{ID: 2, Name: "AVC"},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success #2 - No Filter",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "total_count"}).
+					AddRow(1, "AVC", 12)
+
+				mm.ExpectQuery("SELECT (.+) FROM authors").
+					WillReturnRows(rows)
+			},
+			filter: nil,
+			pagination: &presentation.Pagination{
+				Offset: 0,
+				Count:  1,
+			},
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{ID: 1, Name: "AVC"},
+			},
+			mustTotal: 12,
+			mustErr:   false,
+		},
+		{
+			name: "Success #3 - No Pagination",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow(1, "AVC")
+
+				mm.ExpectQuery("SELECT (.+) FROM authors").
+					WillReturnRows(rows)
+			},
+			filter: &presentation.NewsAuthorsFilter{
+				Name:         "ASDASD",
+				NewsAuthorID: 1,
+			},
+			pagination: nil,
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{ID: 1, Name: "AVC"},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success #4 - Filter Name",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow(1, "ASDASD")
+
+				mm.ExpectQuery("SELECT (.+) FROM authors").
+					WillReturnRows(rows)
+			},
+			filter: &presentation.NewsAuthorsFilter{
+				Name: "ASDASD",
+			},
+			pagination: nil,
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{ID: 1, Name: "ASDASD"},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success #5 - Filter ID",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow(1, "ASDASD")
+
+				mm.ExpectQuery("SELECT (.+) FROM authors").
+					WillReturnRows(rows)
+			},
+			filter: &presentation.NewsAuthorsFilter{
+				NewsAuthorID: 1,
+			},
+			pagination: nil,
+			mustReturn: []presentation.GetNewsAuthorsResponse{
+				{ID: 1, Name: "ASDASD"},
+			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mockExp(mock)
+			res, total, err := pgDB.GetBulkNewsAuthors(context.Background(), tc.pagination, tc.filter, tc.sort)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) || tc.mustTotal != total {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsAuthors",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v total %d, expected %v total %d, mustErr %v, err %v", res, total, tc.mustReturn, tc.mustTotal, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_UpdateBulkNewsAuthors(t *testing.T) {
+	pgDB, db, mock, err := initDB()
+	if err != nil {
+		t.Fatalf("Failed init Mock Database")
+	}
+	defer db.Close()
+
+	testcase := []struct {
+		name       string
+		in         []presentation.UpdateNewsAuthorsRequest
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustReturn []int
+		mustErr    bool
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("UPDATE authors SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			in: []presentation.UpdateNewsAuthorsRequest{
+				{
+					ID: 1, Name: "Test",
+				},
+			},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Update All Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id"}).
+					AddRow(1).
+					AddRow(2).
+					AddRow(3).
+					AddRow(4)
+				mm.ExpectQuery("UPDATE authors SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)").
+					WillReturnRows(rows)
+			},
+			in: []presentation.UpdateNewsAuthorsRequest{
+				{ID: 1, Name: "Test"},
+				{ID: 2, Name: "Test"},
+				{ID: 3, Name: "Test"},
+				{ID: 4, Name: "Test"},
+			},
+			mustReturn: []int{1, 2, 3, 4},
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mockExp(mock)
+			res, err := pgDB.UpdateBulkNewsAuthors(context.Background(), tc.in)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_UpdateBulkNewsAuthors",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+
+}
+
+func Test_DeleteBulkNewsAuthors(t *testing.T) {
+	pgDB, db, mock, err := initDB()
+	if err != nil {
+		t.Fatalf("Failed init Mock Database")
+	}
+	defer db.Close()
+
+	testcase := []struct {
+		name       string
+		in         []int
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustReturn []int
+		mustErr    bool
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("DELETE FROM authors WHERE (.+)").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			in:         []int{1, 2},
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Delete All Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id"}).
+					AddRow(1).
+					AddRow(2)
+				mm.ExpectQuery("DELETE FROM authors WHERE (.+)").
+					WillReturnRows(rows)
+			},
+			in:         []int{1, 2},
+			mustReturn: []int{1, 2},
+			mustErr:    false,
+		},
+		{
+			name: "Success - Delete Partial Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id"}).
+					AddRow(1).
+					AddRow(2)
+				mm.ExpectQuery("DELETE FROM authors WHERE (.+)").
+					WillReturnRows(rows)
+			},
+			in:         []int{1, 2, 3, 4},
+			mustReturn: []int{1, 2},
+			mustErr:    false,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mockExp(mock)
+			res, err := pgDB.DeleteBulkNewsAuthors(context.Background(), tc.in)
+
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_DeleteBulkNewsAuthors",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_CreateBulkNewsAuthorsAssoc(t *testing.T) {
+	pgDB, db, mock, err := initDB()
+	if err != nil {
+		t.Fatalf("Failed init Mock Database")
+	}
+	defer db.Close()
+
+	testcase := []struct {
+		name    string
+		in      []presentation.CreateNewsAuthorsAssoc
+		mockExp func(mm sqlmock.Sqlmock)
+		mustErr bool
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectExec(`INSERT INTO assoc_news_authors (.+) SELECT (.+) FROM \(VALUES (.+)\) as new_values`).
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			in: []presentation.CreateNewsAuthorsAssoc{
+				{
+					NewsID:       1,
+					NewsAuthorID: []int{1, 2, 3, 4},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Not All Data Inserted",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectExec(`INSERT INTO assoc_news_authors (.+) SELECT (.+) FROM \(VALUES (.+)\) as new_values`).
+					WillReturnResult(sqlmock.NewResult(4, 12341))
+			},
+			in: []presentation.CreateNewsAuthorsAssoc{
+				{
+					NewsID:       1,
+					NewsAuthorID: []int{1, 2, 3, 4},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Success Create New Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectExec(`INSERT INTO assoc_news_authors (.+) SELECT (.+) FROM \(VALUES (.+)\) as new_values`).
+					WithArgs(1, 1, 0, 1, 2, 1, 1, 3, 2, 1, 4, 3).
+					WillReturnResult(sqlmock.NewResult(4, 4))
+			},
+			in: []presentation.CreateNewsAuthorsAssoc{
+				{
+					NewsID:       1,
+					NewsAuthorID: []int{1, 2, 3, 4},
+				},
+			},
+			mustErr: false,
+		},
+		{
+			name:    "Success - Empty Authors Run No Query",
+			mockExp: func(mm sqlmock.Sqlmock) {},
+			in: []presentation.CreateNewsAuthorsAssoc{
+				{
+					NewsID:       1,
+					NewsAuthorID: []int{},
+				},
+			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mockExp(mock)
+			err := pgDB.CreateBulkNewsAuthorsAssoc(context.Background(), tc.in)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CreateBulkNewsAuthorsAssoc",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+
+}
+
+func Test_CleanNewsAuthorsAssoc(t *testing.T) {
+	pgDB, db, mock, err := initDB()
+	if err != nil {
+		t.Fatalf("Failed init Mock Database")
+	}
+	defer db.Close()
+
+	testcase := []struct {
+		name    string
+		in      []int
+		mockExp func(mm sqlmock.Sqlmock)
+		mustErr bool
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectExec("DELETE FROM assoc_news_authors WHERE").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			in:      []int{1, 2, 3, 4},
+			mustErr: true,
+		},
+		{
+			name: "Success - Success Create New Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectExec("DELETE FROM assoc_news_authors WHERE").
+					WillReturnResult(sqlmock.NewResult(4, 4))
+			},
+			in: []int{1, 2, 3, 4},
+
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcase {
+		t.Run(tc.name, func(tt *testing.T) {
+			tc.mockExp(mock)
+			err := pgDB.CleanNewsAuthorsAssoc(context.Background(), tc.in)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_CleanNewsAuthorsAssoc",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+
+}
@@ -0,0 +1,43 @@
+package postgre
+
+import (
+	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/lib/pq"
+)
+
+// GetNewsRevisions return revisions of news newest first, only the given revision numbers when any is given
+func (db *Postgre) GetNewsRevisions(ctx context.Context, newsID int, revision ...int) (res []presentation.NewsRevision, err error) {
+	q := `SELECT id, news_id, revision, title, content, status, publish_at, unpublish_at, restored_from, revised_by, revised_at FROM news_revisions WHERE news_id = $1 AND (cardinality($2::INT[]) = 0 OR revision = ANY($2)) ORDER BY revision DESC`
+
+	rows, err := db.queryRead(ctx, "GetNewsRevisions", q, newsID, pq.Array(revision))
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetNewsRevisions",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		var _r presentation.NewsRevision
+
+		err = rows.StructScan(&_r)
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "GetNewsRevisions",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
+		}
+
+		res = append(res, _r)
+	}
+
+	return res, nil
+}
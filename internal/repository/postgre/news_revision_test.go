@@ -0,0 +1,85 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/lib/pq"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_GetNewsRevisions(t *testing.T) {
+	now := time.Now()
+	by := "user-1"
+	restoredFrom := 1
+
+	testcases := []struct {
+		name       string
+		revision   []int
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []presentation.NewsRevision
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news_revisions WHERE news_id = (.+) ORDER BY revision DESC").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Every Revision",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news_revisions WHERE news_id = (.+) ORDER BY revision DESC").
+					WithArgs(1, pq.Array([]int(nil))).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "news_id", "revision", "title", "content", "status", "publish_at", "unpublish_at", "restored_from", "revised_by", "revised_at"}).
+						AddRow(3, 1, 3, "A", "B", presentation.NEWS_STATUS_DRAFT, nil, nil, restoredFrom, by, now).
+						AddRow(1, 1, 1, "A", "B", presentation.NEWS_STATUS_DRAFT, nil, nil, nil, nil, now))
+			},
+			mustReturn: []presentation.NewsRevision{
+				{ID: 3, NewsID: 1, Revision: 3, Title: "A", Content: "B", Status: presentation.NEWS_STATUS_DRAFT, RestoredFrom: &restoredFrom, RevisedBy: &by, RevisedAt: now},
+				{ID: 1, NewsID: 1, Revision: 1, Title: "A", Content: "B", Status: presentation.NEWS_STATUS_DRAFT, RevisedAt: now},
+			},
+		},
+		{
+			name:     "Success - Given Revision",
+			revision: []int{2},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news_revisions WHERE news_id = (.+) ORDER BY revision DESC").
+					WithArgs(1, pq.Array([]int{2})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "news_id", "revision", "title", "content", "status", "publish_at", "unpublish_at", "restored_from", "revised_by", "revised_at"}).
+						AddRow(2, 1, 2, "A", "C", presentation.NEWS_STATUS_IN_REVIEW, now, nil, nil, by, now))
+			},
+			mustReturn: []presentation.NewsRevision{
+				{ID: 2, NewsID: 1, Revision: 2, Title: "A", Content: "C", Status: presentation.NEWS_STATUS_IN_REVIEW, PublishAt: &now, RevisedBy: &by, RevisedAt: now},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, err := pgDB.GetNewsRevisions(context.Background(), 1, tc.revision...)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetNewsRevisions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
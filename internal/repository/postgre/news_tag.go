@@ -1,6 +1,7 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
@@ -8,7 +9,7 @@ import (
 	"github.com/lib/pq"
 )
 
-func (db *Postgre) CreateBulkNewsTags(in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
+func (db *Postgre) CreateBulkNewsTags(ctx context.Context, in []presentation.CreateNewsTagsRequest) (insertedID []int, err error) {
 	q := `INSERT INTO news_tags (name) VALUES`
 
 	queryParamLen := 1
@@ -25,7 +26,7 @@ func (db *Postgre) CreateBulkNewsTags(in []presentation.CreateNewsTagsRequest) (
 	// Remove Comma From end of line and Fetch ID after creation
 	q = fmt.Sprintf("%s RETURNING id", q[:len(q)-1])
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -55,36 +56,48 @@ func (db *Postgre) CreateBulkNewsTags(in []presentation.CreateNewsTagsRequest) (
 	return insertedID, nil
 }
 
-func (db *Postgre) GetBulkNewsTags(pagination *presentation.Pagination, filter *presentation.NewsTagsFilter) (res []presentation.GetNewsTagsResponse, err error) {
-	q := `SELECT id, name FROM news_tags `
+func (db *Postgre) GetBulkNewsTags(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsTagsFilter, sort string) (res []presentation.GetNewsTagsResponse, total int64, err error) {
+	q := `SELECT id, name, COUNT(*) OVER() as total_count FROM news_tags `
 
-	paramCount := 0
-	paramArgs := []interface{}{}
+	where := dbutils.And()
 
 	// Apply Filter if Available
 	if filter != nil {
 		if filter.NewsTagID != 0 {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "id", dbutils.COMPARATOR_EQUAL, paramCount)
-			paramArgs = append(paramArgs, filter.NewsTagID)
+			where.Add(dbutils.Equal("id", filter.NewsTagID))
 		}
 
 		if filter.Name != "" {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "name", dbutils.COMPARATOR_LIKE, paramCount)
-			paramArgs = append(paramArgs, fmt.Sprintf("%%%s%%", filter.Name))
+			where.Add(dbutils.Like("name", fmt.Sprintf("%%%s%%", filter.Name)))
 		}
 	}
 
+	whereClause, paramArgs := where.Where(0)
+	q = fmt.Sprintf("%s%s", q, whereClause)
+	paramCount := len(paramArgs)
+	countQuery, countArgs := fmt.Sprintf("SELECT id FROM news_tags%s", whereClause), paramArgs
+
+	// Implement Ordering, sort on id when not requested
+	q, err = dbutils.AddSort(q, sort, NEWS_TAG_SORT_COLUMNS, NEWS_TAG_SORT_TIE_BREAKER)
+	if err != nil {
+		return nil, 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetBulkNewsTags",
+			Description:  "invalid sort",
+			Trace:        err,
+		}.Error()
+	}
+
 	// Implement Pagination if Any
 	if pagination != nil {
 		q = fmt.Sprintf("%s LIMIT $%d OFFSET $%d", q, paramCount+1, paramCount+2)
 		paramArgs = append(paramArgs, pagination.Count, pagination.Offset)
 	}
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.queryRead(ctx, "GetBulkNewsTags", q, paramArgs...)
 	if err != nil {
-		return nil, response.InternalError{
+		return nil, 0, response.InternalError{
 			Type:         "Repo",
 			Name:         "Postgre",
 			FunctionName: "GetBulkNewsTags",
@@ -94,11 +107,14 @@ func (db *Postgre) GetBulkNewsTags(pagination *presentation.Pagination, filter *
 	}
 
 	for rows.Next() {
-		var _t presentation.GetNewsTagsResponse
+		var _t struct {
+			presentation.GetNewsTagsResponse
+			TotalCount int64 `db:"total_count"`
+		}
 
 		err = rows.StructScan(&_t)
 		if err != nil {
-			return nil, response.InternalError{
+			return nil, 0, response.InternalError{
 				Type:         "Repo",
 				Name:         "Postgre",
 				FunctionName: "GetBulkNewsTags",
@@ -107,13 +123,22 @@ func (db *Postgre) GetBulkNewsTags(pagination *presentation.Pagination, filter *
 			}.Error()
 		}
 
-		res = append(res, _t)
+		res = append(res, _t.GetNewsTagsResponse)
+		total = _t.TotalCount
+	}
+
+	// Page past the last row carry no window total
+	if len(res) == 0 && pagination != nil && pagination.Offset > 0 {
+		total, err = db.countRows(ctx, "GetBulkNewsTags", countQuery, countArgs...)
+		if err != nil {
+			return nil, 0, err
+		}
 	}
 
-	return res, nil
+	return res, total, nil
 }
 
-func (db *Postgre) UpdateBulkNewsTags(in []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
+func (db *Postgre) UpdateBulkNewsTags(ctx context.Context, in []presentation.UpdateNewsTagsRequest) (updatedID []int, err error) {
 	q := `UPDATE news_tags SET name = new_values.name FROM (VALUES %s) as new_values (id, name) WHERE news_tags.id = new_values.id RETURNING news_tags.id`
 
 	queryParamLen := 2
@@ -130,7 +155,7 @@ func (db *Postgre) UpdateBulkNewsTags(in []presentation.UpdateNewsTagsRequest) (
 
 	q = fmt.Sprintf(q, queryValues[:len(queryValues)-1])
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -160,10 +185,10 @@ func (db *Postgre) UpdateBulkNewsTags(in []presentation.UpdateNewsTagsRequest) (
 	return updatedID, nil
 }
 
-func (db *Postgre) DeleteBulkNewsTags(newsTopicID []int) (deletedID []int, err error) {
+func (db *Postgre) DeleteBulkNewsTags(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
 	q := `DELETE FROM news_tags WHERE id = ANY($1) RETURNING id`
 
-	rows, err := db.newsDatabase.Master.Queryx(q, pq.Array(newsTopicID))
+	rows, err := db.writer().QueryxContext(ctx, q, pq.Array(newsTopicID))
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -193,7 +218,7 @@ func (db *Postgre) DeleteBulkNewsTags(newsTopicID []int) (deletedID []int, err e
 	return deletedID, nil
 }
 
-func (db *Postgre) CreateBulkNewsTagsAssoc(in []presentation.CreateNewsTagsAssoc) (err error) {
+func (db *Postgre) CreateBulkNewsTagsAssoc(ctx context.Context, in []presentation.CreateNewsTagsAssoc) (err error) {
 	q := `INSERT INTO assoc_news_tags (news_id, news_tag_id) VALUES`
 
 	queryParamLen := 2
@@ -212,7 +237,7 @@ func (db *Postgre) CreateBulkNewsTagsAssoc(in []presentation.CreateNewsTagsAssoc
 		}
 	}
 
-	res, err := db.newsDatabase.Master.Exec(q[:len(q)-1], paramArgs...)
+	res, err := db.writer().ExecContext(ctx, q[:len(q)-1], paramArgs...)
 	if err != nil {
 		return response.InternalError{
 			Type:         "Repo",
@@ -247,10 +272,10 @@ func (db *Postgre) CreateBulkNewsTagsAssoc(in []presentation.CreateNewsTagsAssoc
 	return nil
 }
 
-func (db *Postgre) CleanNewsTagAssoc(newsID []int) (err error) {
+func (db *Postgre) CleanNewsTagAssoc(ctx context.Context, newsID []int) (err error) {
 	q := `DELETE FROM assoc_news_tags WHERE news_id = ANY($1)`
 
-	_, err = db.newsDatabase.Master.Exec(q, pq.Array(newsID))
+	_, err = db.writer().ExecContext(ctx, q, pq.Array(newsID))
 	if err != nil {
 		return response.InternalError{
 			Type:         "Repo",
@@ -1,6 +1,7 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
@@ -30,9 +31,9 @@ func Test_CreateBulkNewsTags(t *testing.T) {
 					WillReturnError(fmt.Errorf("hello"))
 			},
 			in: []presentation.CreateNewsTagsRequest{
-				{"A"},
-				{"B"},
-				{"C"},
+				{Name: "A"},
+				{Name: "B"},
+				{Name: "C"},
 			},
 			mustReturn: nil,
 			mustErr:    true,
@@ -48,8 +49,8 @@ func Test_CreateBulkNewsTags(t *testing.T) {
 					WillReturnRows(rows)
 			},
 			in: []presentation.CreateNewsTagsRequest{
-				{"A"},
-				{"B"},
+				{Name: "A"},
+				{Name: "B"},
 			},
 			mustReturn: []int{1, 2},
 			mustErr:    false,
@@ -59,7 +60,7 @@ func Test_CreateBulkNewsTags(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			res, err := pgDB.CreateBulkNewsTags(tc.in)
+			res, err := pgDB.CreateBulkNewsTags(context.Background(), tc.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
 				tt.Error(response.InternalTestError{
@@ -85,10 +86,64 @@ func Test_GetBulkNewsTags(t *testing.T) {
 		name       string
 		filter     *presentation.NewsTagsFilter
 		pagination *presentation.Pagination
+		sort       string
 		mockExp    func(mm sqlmock.Sqlmock)
 		mustReturn []presentation.GetNewsTagsResponse
+		mustTotal  int64
 		mustErr    bool
 	}{
+		{
+			name:       "Failed - Sort Field Not Allowed",
+			mockExp:    func(mm sqlmock.Sqlmock) {},
+			sort:       "created_at",
+			mustReturn: nil,
+			mustErr:    true,
+		},
+		{
+			name: "Success - Sorted By Name Descending",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name"}).
+					AddRow(2, "B").
+					AddRow(1, "A")
+
+				mm.ExpectQuery(`SELECT (.+) FROM news_tags ORDER BY name DESC, id ASC$`).
+					WillReturnRows(rows)
+			},
+			sort: "-name",
+			mustReturn: []presentation.GetNewsTagsResponse{
+				{ID: 2, Name: "B"},
+				{ID: 1, Name: "A"},
+			},
+			mustErr: false,
+		},
+		{
+			name: "Success - Offset Past Last Page Count Separately",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news_tags ORDER BY id ASC LIMIT \$1 OFFSET \$2$`).
+					WithArgs(int64(10), int64(20)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "total_count"}))
+
+				mm.ExpectQuery(`SELECT COUNT\(\*\) FROM \(SELECT id FROM news_tags\) matched`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+			},
+			pagination: &presentation.Pagination{Offset: 20, Count: 10},
+			mustReturn: nil,
+			mustTotal:  12,
+			mustErr:    false,
+		},
+		{
+			name: "Failed - Count Query Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news_tags ORDER BY id ASC LIMIT \$1 OFFSET \$2$`).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "total_count"}))
+
+				mm.ExpectQuery(`SELECT COUNT\(\*\) FROM`).
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			pagination: &presentation.Pagination{Offset: 20, Count: 10},
+			mustReturn: nil,
+			mustErr:    true,
+		},
 		{
 			name: "Failed - SQL Return Error",
 			mockExp: func(mm sqlmock.Sqlmock) {
@@ -121,8 +176,8 @@ func Test_GetBulkNewsTags(t *testing.T) {
 		{
 			name: "Success #2 - No Filter",
 			mockExp: func(mm sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "name"}).
-					AddRow(1, "AVC")
+				rows := sqlmock.NewRows([]string{"id", "name", "total_count"}).
+					AddRow(1, "AVC", 12)
 
 				mm.ExpectQuery("SELECT (.+) FROM news_tags").
 					WillReturnRows(rows)
@@ -135,7 +190,8 @@ func Test_GetBulkNewsTags(t *testing.T) {
 			mustReturn: []presentation.GetNewsTagsResponse{
 				{ID: 1, Name: "AVC"},
 			},
-			mustErr: false,
+			mustTotal: 12,
+			mustErr:   false,
 		},
 		{
 			name: "Success #3 - No Pagination",
@@ -197,14 +253,14 @@ func Test_GetBulkNewsTags(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			res, err := pgDB.GetBulkNewsTags(tc.pagination, tc.filter)
+			res, total, err := pgDB.GetBulkNewsTags(context.Background(), tc.pagination, tc.filter, tc.sort)
 
-			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) || tc.mustTotal != total {
 				tt.Error(response.InternalTestError{
 					Name:         tt.Name(),
 					FunctionName: "Test_GetBulkNewsTags",
 					Description:  "Testcase run unsuccessfully",
-					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+					Trace:        fmt.Sprintf("got %v total %d, expected %v total %d, mustErr %v, err %v", res, total, tc.mustReturn, tc.mustTotal, tc.mustErr, err),
 				}.Error())
 			}
 		})
@@ -233,7 +289,7 @@ func Test_UpdateBulkNewsTags(t *testing.T) {
 			},
 			in: []presentation.UpdateNewsTagsRequest{
 				{
-					1, "Test",
+					ID: 1, Name: "Test",
 				},
 			},
 			mustReturn: nil,
@@ -251,10 +307,10 @@ func Test_UpdateBulkNewsTags(t *testing.T) {
 					WillReturnRows(rows)
 			},
 			in: []presentation.UpdateNewsTagsRequest{
-				{1, "Test"},
-				{2, "Test"},
-				{3, "Test"},
-				{4, "Test"},
+				{ID: 1, Name: "Test"},
+				{ID: 2, Name: "Test"},
+				{ID: 3, Name: "Test"},
+				{ID: 4, Name: "Test"},
 			},
 			mustReturn: []int{1, 2, 3, 4},
 			mustErr:    false,
@@ -264,7 +320,7 @@ func Test_UpdateBulkNewsTags(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			res, err := pgDB.UpdateBulkNewsTags(tc.in)
+			res, err := pgDB.UpdateBulkNewsTags(context.Background(), tc.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
 				tt.Error(response.InternalTestError{
@@ -334,7 +390,7 @@ func Test_DeleteBulkNewsTags(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			res, err := pgDB.DeleteBulkNewsTags(tc.in)
+			res, err := pgDB.DeleteBulkNewsTags(context.Background(), tc.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
 				tt.Error(response.InternalTestError{
@@ -408,7 +464,7 @@ func Test_CreateBulkNewsTagsAssoc(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			err := pgDB.CreateBulkNewsTagsAssoc(tc.in)
+			err := pgDB.CreateBulkNewsTagsAssoc(context.Background(), tc.in)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
@@ -460,7 +516,7 @@ func Test_CleanNewsTagsAssoc(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			err := pgDB.CleanNewsTagAssoc(tc.in)
+			err := pgDB.CleanNewsTagAssoc(context.Background(), tc.in)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
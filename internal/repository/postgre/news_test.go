@@ -1,6 +1,7 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
@@ -35,10 +36,10 @@ func Test_CreateNews(t *testing.T) {
 			},
 		}
 
-		mock.ExpectQuery("INSERT INTO news (.+) VALUES (.+) RETURNING id").
+		mock.ExpectQuery("INSERT INTO news (.+) VALUES (.+) RETURNING (.+)INSERT INTO news_revisions (.+) SELECT id FROM inserted").
 			WillReturnError(fmt.Errorf("hello"))
 
-		_, err = pgDB.CreateBulkNews(in)
+		_, err = pgDB.CreateBulkNews(context.Background(), in)
 
 		if err == nil {
 			tt.Error(response.InternalTestError{
@@ -63,11 +64,11 @@ func Test_CreateNews(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id"}).
 			AddRow(1)
 
-		mock.ExpectQuery("INSERT INTO news (.+) VALUES (.+) RETURNING id").
-			WithArgs(in[0].Title, in[0].Content, in[0].Status).
+		mock.ExpectQuery("INSERT INTO news (.+) VALUES (.+) RETURNING (.+)INSERT INTO news_revisions (.+) SELECT id FROM inserted").
+			WithArgs(in[0].Title, in[0].Content, in[0].Status, in[0].CreatedBy, in[0].PublishAt, in[0].UnpublishAt).
 			WillReturnRows(rows)
 
-		_, err = pgDB.CreateBulkNews(in)
+		_, err = pgDB.CreateBulkNews(context.Background(), in)
 		if err != nil {
 			tt.Error(response.InternalTestError{
 				Name:         "Success #1 - Success Create New Rows",
@@ -103,10 +104,10 @@ func Test_GetNews(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM news LEFT JOIN (.+) LEFT JOIN (.+) LEFT JOIN (.+) LEFT JOIN (.+) GROUP BY (.+) LIMIT (.+) OFFSET (.+)").
 			WillReturnError(fmt.Errorf("hello"))
 
-		_, err = pgDB.GetBulkNews(defaultPagination, &presentation.NewsFilter{
+		_, _, err = pgDB.GetBulkNews(context.Background(), defaultPagination, &presentation.NewsFilter{
 			Status: 1,
 			Topics: []int{1, 2, 3},
-		})
+		}, "")
 
 		if err == nil {
 			tt.Error(response.InternalTestError{
@@ -127,7 +128,7 @@ func Test_GetNews(t *testing.T) {
 			WithArgs(defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNews(defaultPagination, nil)
+		_, _, err = pgDB.GetBulkNews(context.Background(), defaultPagination, nil, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -148,7 +149,7 @@ func Test_GetNews(t *testing.T) {
 			WithArgs(1, defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNews(defaultPagination, &presentation.NewsFilter{Status: 1})
+		_, _, err = pgDB.GetBulkNews(context.Background(), defaultPagination, &presentation.NewsFilter{Status: 1}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -169,7 +170,7 @@ func Test_GetNews(t *testing.T) {
 			WithArgs(pq.Array([]int{1}), defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNews(defaultPagination, &presentation.NewsFilter{Topics: []int{1}})
+		_, _, err = pgDB.GetBulkNews(context.Background(), defaultPagination, &presentation.NewsFilter{Topics: []int{1}}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -190,7 +191,7 @@ func Test_GetNews(t *testing.T) {
 			WithArgs(1, pq.Array([]int{2}), defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNews(defaultPagination, &presentation.NewsFilter{Status: 1, Topics: []int{2}})
+		_, _, err = pgDB.GetBulkNews(context.Background(), defaultPagination, &presentation.NewsFilter{Status: 1, Topics: []int{2}}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -211,7 +212,7 @@ func Test_GetNews(t *testing.T) {
 			WithArgs("%TEST%", defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNews(defaultPagination, &presentation.NewsFilter{Title: "TEST"})
+		_, _, err = pgDB.GetBulkNews(context.Background(), defaultPagination, &presentation.NewsFilter{Title: "TEST"}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -247,10 +248,10 @@ func Test_UpdateNews(t *testing.T) {
 			},
 		}
 
-		mock.ExpectQuery("UPDATE news SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)").
+		mock.ExpectQuery("UPDATE news SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)INSERT INTO news_revisions (.+) SELECT id FROM updated").
 			WillReturnError(fmt.Errorf("hello"))
 
-		_, err = pgDB.UpdateBulkNews(in)
+		_, err = pgDB.UpdateBulkNews(context.Background(), in)
 
 		if err == nil {
 			tt.Error(response.InternalTestError{
@@ -288,11 +289,11 @@ func Test_UpdateNews(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id"}).
 			AddRow(1)
 
-		mock.ExpectQuery("UPDATE news SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)").
-			WithArgs(in[0].ID, in[0].Title, in[0].Content, in[0].Status, in[1].ID, in[1].Title, in[1].Content, in[1].Status, in[2].ID, in[2].Title, in[2].Content, in[2].Status).
+		mock.ExpectQuery("UPDATE news SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)INSERT INTO news_revisions (.+) SELECT id FROM updated").
+			WithArgs(in[0].ID, in[0].Title, in[0].Content, in[0].PublishAt, in[0].UnpublishAt, in[0].UpdatedBy, in[0].RestoredFrom, in[1].ID, in[1].Title, in[1].Content, in[1].PublishAt, in[1].UnpublishAt, in[1].UpdatedBy, in[1].RestoredFrom, in[2].ID, in[2].Title, in[2].Content, in[2].PublishAt, in[2].UnpublishAt, in[2].UpdatedBy, in[2].RestoredFrom).
 			WillReturnRows(rows)
 
-		res, err := pgDB.UpdateBulkNews(in)
+		res, err := pgDB.UpdateBulkNews(context.Background(), in)
 		if err != nil {
 			tt.Error(response.InternalTestError{
 				Name:         "Success #1 - Success Update Some Rows",
@@ -329,11 +330,11 @@ func Test_UpdateNews(t *testing.T) {
 			AddRow(1).
 			AddRow(2)
 
-		mock.ExpectQuery("UPDATE news SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)").
-			WithArgs(in[0].ID, in[0].Title, in[0].Content, in[0].Status).
+		mock.ExpectQuery("UPDATE news SET (.+) FROM (.+) WHERE (.+) RETURNING (.+)INSERT INTO news_revisions (.+) SELECT id FROM updated").
+			WithArgs(in[0].ID, in[0].Title, in[0].Content, in[0].PublishAt, in[0].UnpublishAt, in[0].UpdatedBy, in[0].RestoredFrom).
 			WillReturnRows(rows)
 
-		res, err := pgDB.UpdateBulkNews(in)
+		res, err := pgDB.UpdateBulkNews(context.Background(), in)
 		if err != nil {
 			tt.Error(response.InternalTestError{
 				Name:         "Success #2 - Success Update All Rows",
@@ -372,10 +373,10 @@ func Test_DeleteBulkNews(t *testing.T) {
 	t.Run("Failed - SQL Return Error", func(tt *testing.T) {
 		in := []int{1, 2, 3}
 
-		mock.ExpectQuery("DELETE FROM (.+) WHERE (.+) RETURNING (.+)").
+		mock.ExpectQuery("UPDATE news SET (.+) WHERE (.+) RETURNING (.+)").
 			WillReturnError(fmt.Errorf("hello"))
 
-		_, err = pgDB.DeleteBulkNews(in)
+		_, err = pgDB.DeleteBulkNews(context.Background(), in)
 
 		if err == nil {
 			tt.Error(response.InternalTestError{
@@ -397,11 +398,11 @@ func Test_DeleteBulkNews(t *testing.T) {
 			AddRow(3).
 			AddRow(4)
 
-		mock.ExpectQuery("DELETE FROM (.+) WHERE (.+) RETURNING (.+)").
-			WithArgs(pq.Array(in)).
+		mock.ExpectQuery("UPDATE news SET (.+) WHERE (.+) RETURNING (.+)").
+			WithArgs(presentation.NEWS_STATUS_DELETED, pq.Array(in)).
 			WillReturnRows(rows)
 
-		res, err := pgDB.DeleteBulkNews(in)
+		res, err := pgDB.DeleteBulkNews(context.Background(), in)
 		if err != nil {
 			tt.Error(response.InternalTestError{
 				Name:         "Success #1 - Success Delete Some Rows",
@@ -433,11 +434,11 @@ func Test_DeleteBulkNews(t *testing.T) {
 			AddRow(3).
 			AddRow(4)
 
-		mock.ExpectQuery("DELETE FROM (.+) WHERE (.+) RETURNING (.+)").
-			WithArgs(pq.Array(in)).
+		mock.ExpectQuery("UPDATE news SET (.+) WHERE (.+) RETURNING (.+)").
+			WithArgs(presentation.NEWS_STATUS_DELETED, pq.Array(in)).
 			WillReturnRows(rows)
 
-		res, err := pgDB.DeleteBulkNews(in)
+		res, err := pgDB.DeleteBulkNews(context.Background(), in)
 		if err != nil {
 			tt.Error(response.InternalTestError{
 				Name:         "Success #2 - Success Delete All Rows",
@@ -460,3 +461,693 @@ func Test_DeleteBulkNews(t *testing.T) {
 	})
 
 }
+
+func Test_RestoreBulkNews(t *testing.T) {
+	testcases := []struct {
+		name       string
+		in         []int
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			in:   []int{1},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("UPDATE news SET (.+) WHERE (.+) deleted_at IS NOT NULL RETURNING id").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Restore Deleted Rows Only",
+			in:   []int{1, 2},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("UPDATE news SET (.+) WHERE (.+) deleted_at IS NOT NULL RETURNING id").
+					WithArgs(presentation.NEWS_STATUS_DRAFT, pq.Array([]int{1, 2})).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+			},
+			mustReturn: []int{2},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, err := pgDB.RestoreBulkNews(context.Background(), tc.in)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_RestoreBulkNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_PurgeDeletedNews(t *testing.T) {
+	deletedBefore := time.Now().Add(-time.Hour)
+
+	testcases := []struct {
+		name       string
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("DELETE FROM news WHERE deleted_at IS NOT NULL AND deleted_at < (.+) RETURNING id").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Purge Expired Rows",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("DELETE FROM news WHERE deleted_at IS NOT NULL AND deleted_at < (.+) RETURNING id").
+					WithArgs(deletedBefore).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+			},
+			mustReturn: []int{3, 4},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, err := pgDB.PurgeDeletedNews(context.Background(), deletedBefore)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_PurgeDeletedNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetBulkNewsAssocItems(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status", "deleted_at", "topics", "tags"}
+
+	testcases := []struct {
+		name       string
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []presentation.GetNewsResponse
+	}{
+		{
+			name: "Failed - Invalid Aggregated JSON",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news (.+) WHERE news.deleted_at IS NULL").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, now, now, "a", "b", "", "", 1, nil, []byte(`{`), []byte(`[]`)))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Topics And Tags Scanned",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news (.+) WHERE news.deleted_at IS NULL").
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, now, now, "a", "b", "X, Y", "", 1, nil, []byte(`[{"id": 1, "name": "X"}, {"id": 2, "name": "Y"}]`), []byte(`[]`)))
+			},
+			mustReturn: []presentation.GetNewsResponse{
+				{
+					ID:         1,
+					CreatedAt:  now,
+					UpdatedAt:  now,
+					Title:      "a",
+					Content:    "b",
+					Status:     1,
+					Topics:     presentation.NewsAssocItems{{ID: 1, Name: "X"}, {ID: 2, Name: "Y"}},
+					Tags:       presentation.NewsAssocItems{},
+					TopicsName: "X, Y",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, _, err := pgDB.GetBulkNews(context.Background(), presentation.Pagination{Count: 1}, nil, "")
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsAssocItems",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %+v, expected %+v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetBulkNewsCursor(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status", "total_count"}
+
+	cursorPagination := func(direction string) presentation.Pagination {
+		return presentation.Pagination{Count: 2, Cursor: "cursor", Position: &presentation.NewsCursor{CreatedAt: now, ID: 5, Direction: direction}}
+	}
+
+	testcases := []struct {
+		name       string
+		pagination presentation.Pagination
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustID     []int64
+		mustTotal  int64
+	}{
+		{
+			name: "Success - Next Page",
+			pagination: func() presentation.Pagination {
+				pagination := cursorPagination(presentation.CURSOR_DIRECTION_NEXT)
+				pagination.Offset = 10
+				return pagination
+			}(),
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.deleted_at IS NULL AND \(news.created_at, news.id\) < \(\$1, \$2\) GROUP BY news.id ORDER BY news.created_at DESC, news.id DESC LIMIT \$3$`).
+					WithArgs(now, int64(5), int64(2)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(4, now, now, "a", "b", "", "", 1, 3).AddRow(3, now, now, "a", "b", "", "", 1, 3))
+			},
+			mustID:    []int64{4, 3},
+			mustTotal: 3,
+		},
+		{
+			name:       "Success - Previous Page Reversed",
+			pagination: cursorPagination(presentation.CURSOR_DIRECTION_PREV),
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.deleted_at IS NULL AND \(news.created_at, news.id\) > \(\$1, \$2\) GROUP BY news.id ORDER BY news.created_at ASC, news.id ASC LIMIT \$3$`).
+					WithArgs(now, int64(5), int64(2)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(6, now, now, "a", "b", "", "", 1, 2).AddRow(7, now, now, "a", "b", "", "", 1, 2))
+			},
+			mustID:    []int64{7, 6},
+			mustTotal: 2,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, total, err := pgDB.GetBulkNews(context.Background(), tc.pagination, nil, "")
+
+			var gotID []int64
+			for _, v := range res {
+				gotID = append(gotID, v.ID)
+			}
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(gotID, tc.mustID) || total != tc.mustTotal {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsCursor",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v total %d, expected %v total %d, mustErr %v, err %v", gotID, total, tc.mustID, tc.mustTotal, tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsCursor",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetBulkNewsSort(t *testing.T) {
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status"}
+	pagination := presentation.Pagination{Offset: 0, Count: 5}
+
+	testcases := []struct {
+		name    string
+		sort    string
+		mockExp func(mm sqlmock.Sqlmock)
+		mustErr bool
+	}{
+		{
+			name:    "Failed - Sort Field Not Allowed",
+			sort:    "content",
+			mockExp: func(mm sqlmock.Sqlmock) {},
+			mustErr: true,
+		},
+		{
+			name: "Success - Default Newest First",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) GROUP BY news.id ORDER BY news.created_at DESC, news.id DESC LIMIT \$1 OFFSET \$2$`).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "Success - Requested Sort With Tie Breaker",
+			sort: "status,-title",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) GROUP BY news.id ORDER BY news.status ASC, news.title DESC, news.id DESC LIMIT \$1 OFFSET \$2$`).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			_, _, err = pgDB.GetBulkNews(context.Background(), pagination, nil, tc.sort)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsSort",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsSort",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_SearchNews(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status", "rank", "title_highlight", "content_highlight", "total_count"}
+	pagination := presentation.Pagination{Offset: 0, Count: 5}
+
+	testcases := []struct {
+		name       string
+		pagination presentation.Pagination
+		filter     *presentation.NewsFilter
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []presentation.SearchNewsResponse
+		mustTotal  int64
+	}{
+		{
+			name:       "Failed - Cursor Pagination",
+			pagination: presentation.Pagination{Count: 5, Cursor: "abc"},
+			mockExp:    func(mm sqlmock.Sqlmock) {},
+			mustErr:    true,
+		},
+		{
+			name:       "Failed - SQL Return Error",
+			pagination: pagination,
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name:       "Success - Ranked With Filter",
+			pagination: pagination,
+			filter:     &presentation.NewsFilter{Status: presentation.NEWS_STATUS_PUBLISHED, Topics: []int{2}},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) ts_rank\(news.search_vector, websearch_to_tsquery\('simple', \$1\)\) as rank, (.+) FROM news (.+) WHERE news.search_vector @@ websearch_to_tsquery\('simple', \$1\) AND news.status = \$2 AND EXISTS \(SELECT 1 FROM assoc_news_topics fa WHERE fa.news_id = news.id AND fa.news_topic_id = ANY\(\$3\)\) AND news.deleted_at IS NULL GROUP BY news.id ORDER BY rank DESC, news.id DESC LIMIT \$4 OFFSET \$5$`).
+					WithArgs("market -crypto", presentation.NEWS_STATUS_PUBLISHED, pq.Array([]int{2}), int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(1, now, now, "Market", "b", "", "", 2, 0.6, "<mark>Market</mark>", "b", 1))
+			},
+			mustReturn: []presentation.SearchNewsResponse{
+				{
+					GetNewsResponse:  presentation.GetNewsResponse{ID: 1, CreatedAt: now, UpdatedAt: now, Title: "Market", Content: "b", Status: 2},
+					Rank:             0.6,
+					TitleHighlight:   "<mark>Market</mark>",
+					ContentHighlight: "b",
+				},
+			},
+			mustTotal: 1,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, total, err := pgDB.SearchNews(context.Background(), "market -crypto", tc.pagination, tc.filter)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) || total != tc.mustTotal {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_SearchNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %+v total %d, expected %+v total %d, mustErr %v, err %v", res, total, tc.mustReturn, tc.mustTotal, tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_SearchNews",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetBulkNewsFilter(t *testing.T) {
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status"}
+	pagination := presentation.Pagination{Offset: 0, Count: 5}
+
+	weekAgo := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := weekAgo.Add(7 * 24 * time.Hour)
+
+	testcases := []struct {
+		name    string
+		filter  *presentation.NewsFilter
+		mockExp func(mm sqlmock.Sqlmock)
+		mustErr bool
+	}{
+		{
+			name: "Success - Multi Value And Any Match",
+			filter: &presentation.NewsFilter{
+				NewsIDs:  []int{1, 2},
+				Statuses: []int{presentation.NEWS_STATUS_DRAFT, presentation.NEWS_STATUS_PUBLISHED},
+				Topics:   []int{3},
+				Tags:     []int{4, 5},
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.id = ANY\(\$1\) AND news.status = ANY\(\$2\) AND EXISTS \(SELECT 1 FROM assoc_news_topics fa WHERE fa.news_id = news.id AND fa.news_topic_id = ANY\(\$3\)\) AND EXISTS \(SELECT 1 FROM assoc_news_tags fa WHERE fa.news_id = news.id AND fa.news_tag_id = ANY\(\$4\)\) AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs(pq.Array([]int{1, 2}), pq.Array([]int{1, 2}), pq.Array([]int{3}), pq.Array([]int{4, 5}), int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "Success - Published Last Week In All Topics Tagged",
+			filter: &presentation.NewsFilter{
+				Status:      presentation.NEWS_STATUS_PUBLISHED,
+				Topics:      []int{1, 2, 2},
+				TopicsMatch: presentation.FILTER_MATCH_ALL,
+				Tags:        []int{7},
+				TagsMatch:   presentation.FILTER_MATCH_ALL,
+				CreatedFrom: &weekAgo,
+				CreatedTo:   &now,
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.status = \$1 AND NOT EXISTS \(SELECT 1 FROM unnest\(\$2::INTEGER\[\]\) AS requested \(id\) WHERE NOT EXISTS \(SELECT 1 FROM assoc_news_topics fa WHERE fa.news_id = news.id AND fa.news_topic_id = requested.id\)\) AND NOT EXISTS \(SELECT 1 FROM unnest\(\$3::INTEGER\[\]\) AS requested \(id\) WHERE NOT EXISTS \(SELECT 1 FROM assoc_news_tags fa WHERE fa.news_id = news.id AND fa.news_tag_id = requested.id\)\) AND news.created_at BETWEEN \$4 AND \$5 AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs(presentation.NEWS_STATUS_PUBLISHED, pq.Array([]int{1, 2, 2}), pq.Array([]int{7}), weekAgo, now, int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "Success - Every Author In Byline",
+			filter: &presentation.NewsFilter{
+				Authors:      []int{8, 9},
+				AuthorsMatch: presentation.FILTER_MATCH_ALL,
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE NOT EXISTS \(SELECT 1 FROM unnest\(\$1::INTEGER\[\]\) AS requested \(id\) WHERE NOT EXISTS \(SELECT 1 FROM assoc_news_authors fa WHERE fa.news_id = news.id AND fa.author_id = requested.id\)\) AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs(pq.Array([]int{8, 9}), int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "Success - Live News For Public Reader",
+			filter: &presentation.NewsFilter{
+				Title: "A",
+				Live:  true,
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.title LIKE \$1 AND news.status = \$2 AND \(news.publish_at IS NULL OR news.publish_at <= now\(\)\) AND \(news.unpublish_at IS NULL OR news.unpublish_at > now\(\)\) AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs("%A%", presentation.NEWS_STATUS_PUBLISHED, int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "Success - Open Ended Updated Range",
+			filter: &presentation.NewsFilter{
+				UpdatedFrom: &weekAgo,
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.updated_at >= \$1 AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs(weekAgo, int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+		{
+			name: "Success - Deleted Status Show Deleted",
+			filter: &presentation.NewsFilter{
+				Statuses:  []int{presentation.NEWS_STATUS_DELETED},
+				UpdatedTo: &now,
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.status = ANY\(\$1\) AND news.updated_at <= \$2 GROUP BY news.id (.+)`).
+					WithArgs(pq.Array([]int{presentation.NEWS_STATUS_DELETED}), now, int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			_, _, err = pgDB.GetBulkNews(context.Background(), pagination, tc.filter, "")
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsFilter",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsFilter",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetBulkNewsAssocFilterKeepAllAssoc(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status", "deleted_at", "topics", "tags"}
+	pagination := presentation.Pagination{Offset: 0, Count: 5}
+
+	// Article has topics X and Y and tag Z, filtered and unfiltered view must both return all of them
+	article := presentation.GetNewsResponse{
+		ID:         1,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Title:      "a",
+		Content:    "b",
+		Status:     1,
+		Topics:     presentation.NewsAssocItems{{ID: 1, Name: "X"}, {ID: 2, Name: "Y"}},
+		Tags:       presentation.NewsAssocItems{{ID: 3, Name: "Z"}},
+		TopicsName: "X, Y",
+		TagsName:   "Z",
+	}
+
+	articleRow := func() *sqlmock.Rows {
+		return sqlmock.NewRows(columns).AddRow(1, now, now, "a", "b", "X, Y", "Z", 1, nil, []byte(`[{"id": 1, "name": "X"}, {"id": 2, "name": "Y"}]`), []byte(`[{"id": 3, "name": "Z"}]`))
+	}
+
+	testcases := []struct {
+		name       string
+		filter     *presentation.NewsFilter
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustReturn []presentation.GetNewsResponse
+	}{
+		{
+			name: "Success - Unfiltered",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WillReturnRows(articleRow())
+			},
+			mustReturn: []presentation.GetNewsResponse{article},
+		},
+		{
+			name:   "Success - Filtered By One Topic And Tag",
+			filter: &presentation.NewsFilter{Topics: []int{1}, Tags: []int{3}},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				// Joined topics and tags rows must not be filtered, only the association subquery
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE EXISTS \(SELECT 1 FROM assoc_news_topics fa (.+)\) AND EXISTS \(SELECT 1 FROM assoc_news_tags fa (.+)\) AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs(pq.Array([]int{1}), pq.Array([]int{3}), int64(5), int64(0)).
+					WillReturnRows(articleRow())
+			},
+			mustReturn: []presentation.GetNewsResponse{article},
+		},
+		{
+			name:   "Success - Filtered By All Topics",
+			filter: &presentation.NewsFilter{Topics: []int{1, 2}, TopicsMatch: presentation.FILTER_MATCH_ALL},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) WHERE NOT EXISTS \(SELECT 1 FROM unnest(.+)\) AND news.deleted_at IS NULL GROUP BY news.id (.+)`).
+					WithArgs(pq.Array([]int{1, 2}), int64(5), int64(0)).
+					WillReturnRows(articleRow())
+			},
+			mustReturn: []presentation.GetNewsResponse{article},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, _, err := pgDB.GetBulkNews(context.Background(), pagination, tc.filter, "")
+
+			if err != nil || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsAssocFilterKeepAllAssoc",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %+v, expected %+v, err %v", res, tc.mustReturn, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsAssocFilterKeepAllAssoc",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetBulkNewsTotalPastLastPage(t *testing.T) {
+	columns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status", "total_count"}
+
+	testcases := []struct {
+		name       string
+		pagination presentation.Pagination
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustTotal  int64
+	}{
+		{
+			name:       "Success - Offset Past Last Page Count Separately",
+			pagination: presentation.Pagination{Count: 5, Offset: 10},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) LIMIT \$1 OFFSET \$2$`).
+					WithArgs(int64(5), int64(10)).
+					WillReturnRows(sqlmock.NewRows(columns))
+
+				mm.ExpectQuery(`SELECT COUNT\(\*\) FROM \(SELECT news.id FROM news (.+) WHERE news.deleted_at IS NULL GROUP BY news.id\) matched`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+			},
+			mustTotal: 7,
+		},
+		{
+			name:       "Success - Empty First Page Skip Count",
+			pagination: presentation.Pagination{Count: 5},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) LIMIT \$1 OFFSET \$2$`).
+					WithArgs(int64(5), int64(0)).
+					WillReturnRows(sqlmock.NewRows(columns))
+			},
+			mustTotal: 0,
+		},
+		{
+			name:       "Failed - Count Query Return Error",
+			pagination: presentation.Pagination{Count: 5, Offset: 10},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`SELECT (.+) FROM news (.+) LIMIT \$1 OFFSET \$2$`).
+					WillReturnRows(sqlmock.NewRows(columns))
+
+				mm.ExpectQuery(`SELECT COUNT\(\*\) FROM`).
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, total, err := pgDB.GetBulkNews(context.Background(), tc.pagination, nil, "")
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || len(res) != 0 || total != tc.mustTotal {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsTotalPastLastPage",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v total %d, expected total %d, mustErr %v, err %v", res, total, tc.mustTotal, tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetBulkNewsTotalPastLastPage",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
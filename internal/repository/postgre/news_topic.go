@@ -1,6 +1,7 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
@@ -8,7 +9,7 @@ import (
 	"github.com/lib/pq"
 )
 
-func (db *Postgre) CreateBulkNewsTopics(in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
+func (db *Postgre) CreateBulkNewsTopics(ctx context.Context, in []presentation.CreateNewsTopicsRequest) (insertedID []int, err error) {
 	q := `INSERT INTO news_topics (name) VALUES`
 
 	queryParamLen := 1
@@ -25,7 +26,7 @@ func (db *Postgre) CreateBulkNewsTopics(in []presentation.CreateNewsTopicsReques
 	// Remove Comma From end of line and Fetch ID after creation
 	q = fmt.Sprintf("%s RETURNING id", q[:len(q)-1])
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -55,36 +56,48 @@ func (db *Postgre) CreateBulkNewsTopics(in []presentation.CreateNewsTopicsReques
 	return insertedID, nil
 }
 
-func (db *Postgre) GetBulkNewsTopics(pagination *presentation.Pagination, filter *presentation.NewsTopicFilter) (res []presentation.GetNewsTopicsResponse, err error) {
-	q := `SELECT id, name FROM news_topics `
+func (db *Postgre) GetBulkNewsTopics(ctx context.Context, pagination *presentation.Pagination, filter *presentation.NewsTopicFilter, sort string) (res []presentation.GetNewsTopicsResponse, total int64, err error) {
+	q := `SELECT id, name, COUNT(*) OVER() as total_count FROM news_topics `
 
-	paramCount := 0
-	paramArgs := []interface{}{}
+	where := dbutils.And()
 
 	// Apply Filter if Available
 	if filter != nil {
 		if filter.NewsTopicID != 0 {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "id", dbutils.COMPARATOR_EQUAL, paramCount)
-			paramArgs = append(paramArgs, filter.NewsTopicID)
+			where.Add(dbutils.Equal("id", filter.NewsTopicID))
 		}
 
 		if filter.Name != "" {
-			paramCount += 1
-			q = dbutils.AddFilter(q, dbutils.CONNECTOR_AND, "name", dbutils.COMPARATOR_LIKE, paramCount)
-			paramArgs = append(paramArgs, fmt.Sprintf("%%%s%%", filter.Name))
+			where.Add(dbutils.Like("name", fmt.Sprintf("%%%s%%", filter.Name)))
 		}
 	}
 
+	whereClause, paramArgs := where.Where(0)
+	q = fmt.Sprintf("%s%s", q, whereClause)
+	paramCount := len(paramArgs)
+	countQuery, countArgs := fmt.Sprintf("SELECT id FROM news_topics%s", whereClause), paramArgs
+
+	// Implement Ordering, sort on id when not requested
+	q, err = dbutils.AddSort(q, sort, NEWS_TOPIC_SORT_COLUMNS, NEWS_TOPIC_SORT_TIE_BREAKER)
+	if err != nil {
+		return nil, 0, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetBulkNewsTopics",
+			Description:  "invalid sort",
+			Trace:        err,
+		}.Error()
+	}
+
 	// Implement Pagination if Any
 	if pagination != nil {
 		q = fmt.Sprintf("%s LIMIT $%d OFFSET $%d", q, paramCount+1, paramCount+2)
 		paramArgs = append(paramArgs, pagination.Count, pagination.Offset)
 	}
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.queryRead(ctx, "GetBulkNewsTopics", q, paramArgs...)
 	if err != nil {
-		return nil, response.InternalError{
+		return nil, 0, response.InternalError{
 			Type:         "Repo",
 			Name:         "Postgre",
 			FunctionName: "GetBulkNewsTopics",
@@ -94,11 +107,14 @@ func (db *Postgre) GetBulkNewsTopics(pagination *presentation.Pagination, filter
 	}
 
 	for rows.Next() {
-		var _t presentation.GetNewsTopicsResponse
+		var _t struct {
+			presentation.GetNewsTopicsResponse
+			TotalCount int64 `db:"total_count"`
+		}
 
 		err = rows.StructScan(&_t)
 		if err != nil {
-			return nil, response.InternalError{
+			return nil, 0, response.InternalError{
 				Type:         "Repo",
 				Name:         "Postgre",
 				FunctionName: "GetBulkNewsTopics",
@@ -107,13 +123,22 @@ func (db *Postgre) GetBulkNewsTopics(pagination *presentation.Pagination, filter
 			}.Error()
 		}
 
-		res = append(res, _t)
+		res = append(res, _t.GetNewsTopicsResponse)
+		total = _t.TotalCount
+	}
+
+	// Page past the last row carry no window total
+	if len(res) == 0 && pagination != nil && pagination.Offset > 0 {
+		total, err = db.countRows(ctx, "GetBulkNewsTopics", countQuery, countArgs...)
+		if err != nil {
+			return nil, 0, err
+		}
 	}
 
-	return res, nil
+	return res, total, nil
 }
 
-func (db *Postgre) UpdateBulkNewsTopics(in []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
+func (db *Postgre) UpdateBulkNewsTopics(ctx context.Context, in []presentation.UpdateNewsTopicsRequest) (updatedID []int, err error) {
 	q := `UPDATE news_topics SET name = new_values.name FROM (VALUES %s) as new_values (id, name) WHERE news_topics.id = new_values.id RETURNING news_topics.id`
 
 	queryParamLen := 2
@@ -130,7 +155,7 @@ func (db *Postgre) UpdateBulkNewsTopics(in []presentation.UpdateNewsTopicsReques
 
 	q = fmt.Sprintf(q, queryValues[:len(queryValues)-1])
 
-	rows, err := db.newsDatabase.Master.Queryx(q, paramArgs...)
+	rows, err := db.writer().QueryxContext(ctx, q, paramArgs...)
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -160,10 +185,10 @@ func (db *Postgre) UpdateBulkNewsTopics(in []presentation.UpdateNewsTopicsReques
 	return updatedID, nil
 }
 
-func (db *Postgre) DeleteBulkNewsTopics(newsTopicID []int) (deletedID []int, err error) {
+func (db *Postgre) DeleteBulkNewsTopics(ctx context.Context, newsTopicID []int) (deletedID []int, err error) {
 	q := `DELETE FROM news_topics WHERE id = ANY($1) RETURNING id`
 
-	rows, err := db.newsDatabase.Master.Queryx(q, pq.Array(newsTopicID))
+	rows, err := db.writer().QueryxContext(ctx, q, pq.Array(newsTopicID))
 	if err != nil {
 		return nil, response.InternalError{
 			Type:         "Repo",
@@ -193,7 +218,7 @@ func (db *Postgre) DeleteBulkNewsTopics(newsTopicID []int) (deletedID []int, err
 	return deletedID, nil
 }
 
-func (db *Postgre) CreateBulkNewsTopicsAssoc(in []presentation.CreateNewsTopicsAssoc) (err error) {
+func (db *Postgre) CreateBulkNewsTopicsAssoc(ctx context.Context, in []presentation.CreateNewsTopicsAssoc) (err error) {
 	q := `INSERT INTO assoc_news_topics (news_id, news_topic_id) VALUES`
 
 	queryParamLen := 2
@@ -212,7 +237,7 @@ func (db *Postgre) CreateBulkNewsTopicsAssoc(in []presentation.CreateNewsTopicsA
 		}
 	}
 
-	res, err := db.newsDatabase.Master.Exec(q[:len(q)-1], paramArgs...)
+	res, err := db.writer().ExecContext(ctx, q[:len(q)-1], paramArgs...)
 	if err != nil {
 		return response.InternalError{
 			Type:         "Repo",
@@ -247,10 +272,10 @@ func (db *Postgre) CreateBulkNewsTopicsAssoc(in []presentation.CreateNewsTopicsA
 	return nil
 }
 
-func (db *Postgre) CleanNewsTopicsAssoc(newsID []int) (err error) {
+func (db *Postgre) CleanNewsTopicsAssoc(ctx context.Context, newsID []int) (err error) {
 	q := `DELETE FROM assoc_news_topics WHERE news_id = ANY($1)`
 
-	_, err = db.newsDatabase.Master.Exec(q, pq.Array(newsID))
+	_, err = db.writer().ExecContext(ctx, q, pq.Array(newsID))
 	if err != nil {
 		return response.InternalError{
 			Type:         "Repo",
@@ -1,6 +1,7 @@
 package postgre
 
 import (
+	"context"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
@@ -27,14 +28,14 @@ func Test_CreateBulkTopics(t *testing.T) {
 	t.Run("Failed - SQL Return Error", func(tt *testing.T) {
 		in := []presentation.CreateNewsTopicsRequest{
 			{
-				"AAA",
+				Name: "AAA",
 			},
 		}
 
 		mock.ExpectQuery("INSERT INTO news_topics (.+) VALUES (.+) RETURNING id").
 			WillReturnError(fmt.Errorf("hello"))
 
-		_, err = pgDB.CreateBulkNewsTopics(in)
+		_, err = pgDB.CreateBulkNewsTopics(context.Background(), in)
 
 		if err == nil {
 			tt.Error(response.InternalTestError{
@@ -50,7 +51,7 @@ func Test_CreateBulkTopics(t *testing.T) {
 	t.Run("Success #1 - Success Create New Rows", func(tt *testing.T) {
 		in := []presentation.CreateNewsTopicsRequest{
 			{
-				"AAA",
+				Name: "AAA",
 			},
 		}
 
@@ -61,7 +62,7 @@ func Test_CreateBulkTopics(t *testing.T) {
 			WithArgs(in[0].Name).
 			WillReturnRows(rows)
 
-		_, err = pgDB.CreateBulkNewsTopics(in)
+		_, err = pgDB.CreateBulkNewsTopics(context.Background(), in)
 		if err != nil {
 			tt.Error(response.InternalTestError{
 				Name:         "Success #1 - Success Create New Rows",
@@ -95,7 +96,7 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM news_topics").
 			WillReturnError(fmt.Errorf("hello"))
 
-		_, err = pgDB.GetBulkNewsTopics(nil, nil)
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), nil, nil, "")
 
 		if err == nil {
 			tt.Error(response.InternalTestError{
@@ -115,7 +116,7 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM news_topics").
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNewsTopics(nil, nil)
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), nil, nil, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -137,9 +138,9 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 			WithArgs("%X%", defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNewsTopics(&defaultPagination, &presentation.NewsTopicFilter{
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), &defaultPagination, &presentation.NewsTopicFilter{
 			Name: "X",
-		})
+		}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -161,9 +162,9 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 			WithArgs(1, defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNewsTopics(&defaultPagination, &presentation.NewsTopicFilter{
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), &defaultPagination, &presentation.NewsTopicFilter{
 			NewsTopicID: 1,
-		})
+		}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -185,10 +186,10 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 			WithArgs(1, "%ABCDE%", defaultPagination.Count, defaultPagination.Offset).
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNewsTopics(&defaultPagination, &presentation.NewsTopicFilter{
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), &defaultPagination, &presentation.NewsTopicFilter{
 			NewsTopicID: 1,
 			Name:        "ABCDE",
-		})
+		}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -210,10 +211,10 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 			WithArgs(1, "%ABCDE%").
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNewsTopics(nil, &presentation.NewsTopicFilter{
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), nil, &presentation.NewsTopicFilter{
 			NewsTopicID: 1,
 			Name:        "ABCDE",
-		})
+		}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -235,10 +236,10 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 			WithArgs(1, "%ABCDE%").
 			WillReturnRows(rows)
 
-		_, err = pgDB.GetBulkNewsTopics(nil, &presentation.NewsTopicFilter{
+		_, _, err = pgDB.GetBulkNewsTopics(context.Background(), nil, &presentation.NewsTopicFilter{
 			NewsTopicID: 1,
 			Name:        "ABCDE",
-		})
+		}, "")
 
 		if err != nil {
 			tt.Error(response.InternalTestError{
@@ -249,6 +250,29 @@ func Test_GetBulkNewsTopics(t *testing.T) {
 			}.Error())
 		}
 	})
+
+	// Success #6 - Offset Past Last Page Count Separately
+	t.Run("Success #6 - Offset Past Last Page Count Separately", func(tt *testing.T) {
+		pagination := presentation.Pagination{Offset: 20, Count: 10}
+
+		mock.ExpectQuery("SELECT (.+) FROM news_topics (.+)").
+			WithArgs(pagination.Count, pagination.Offset).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "total_count"}))
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM \(SELECT id FROM news_topics\) matched`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+		res, total, err := pgDB.GetBulkNewsTopics(context.Background(), &pagination, nil, "")
+
+		if err != nil || len(res) != 0 || total != 12 {
+			tt.Error(response.InternalTestError{
+				Name:         tt.Name(),
+				FunctionName: "Test_GetBulkNewsTopics",
+				Description:  "Testcase run unsuccessfully",
+				Trace:        fmt.Sprintf("got %v total %d, expected total 12, err %v", res, total, err),
+			}.Error())
+		}
+	})
 }
 
 func Test_UpdateBulkNewsTopics(t *testing.T) {
@@ -275,7 +299,7 @@ func Test_UpdateBulkNewsTopics(t *testing.T) {
 			},
 			in: []presentation.UpdateNewsTopicsRequest{
 				{
-					1, "Test",
+					ID: 1, Name: "Test",
 				},
 			},
 			mustReturn: nil,
@@ -293,10 +317,10 @@ func Test_UpdateBulkNewsTopics(t *testing.T) {
 					WillReturnRows(rows)
 			},
 			in: []presentation.UpdateNewsTopicsRequest{
-				{1, "Test"},
-				{2, "Test"},
-				{3, "Test"},
-				{4, "Test"},
+				{ID: 1, Name: "Test"},
+				{ID: 2, Name: "Test"},
+				{ID: 3, Name: "Test"},
+				{ID: 4, Name: "Test"},
 			},
 			mustReturn: []int{1, 2, 3, 4},
 			mustErr:    false,
@@ -306,7 +330,7 @@ func Test_UpdateBulkNewsTopics(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			res, err := pgDB.UpdateBulkNewsTopics(tc.in)
+			res, err := pgDB.UpdateBulkNewsTopics(context.Background(), tc.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
 				tt.Error(response.InternalTestError{
@@ -377,7 +401,7 @@ func Test_DeleteBulkNewsTopics(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			res, err := pgDB.DeleteBulkNewsTopics(tc.in)
+			res, err := pgDB.DeleteBulkNewsTopics(context.Background(), tc.in)
 
 			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual(tc.mustReturn, res) {
 				tt.Error(response.InternalTestError{
@@ -451,7 +475,7 @@ func Test_CreateBulkNewsTopicsAssoc(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			err := pgDB.CreateBulkNewsTopicsAssoc(tc.in)
+			err := pgDB.CreateBulkNewsTopicsAssoc(context.Background(), tc.in)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
@@ -503,7 +527,7 @@ func Test_CleanNewsTopicsAssoc(t *testing.T) {
 	for _, tc := range testcase {
 		t.Run(tc.name, func(tt *testing.T) {
 			tc.mockExp(mock)
-			err := pgDB.CleanNewsTopicsAssoc(tc.in)
+			err := pgDB.CleanNewsTopicsAssoc(context.Background(), tc.in)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
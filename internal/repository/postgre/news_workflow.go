@@ -0,0 +1,113 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/lib/pq"
+)
+
+// TransitionNewsStatus move news to status to when its current status is one of from, and record the transition.
+// Check and change run in single statement, so concurrent transitions of the same news can not both pass.
+// transitioned is false when news is not found, deleted, its status is not one of from, or it is published after its unpublish_at
+func (db *Postgre) TransitionNewsStatus(ctx context.Context, newsID int, transition string, from []int, to int, transitionedBy string) (transitioned bool, err error) {
+	q := fmt.Sprintf(`WITH previous AS (SELECT id, status FROM news WHERE id = $1 AND deleted_at IS NULL AND status = ANY($2) AND %s FOR UPDATE), updated AS (UPDATE news SET status = $3, updated_at = now() FROM previous WHERE news.id = previous.id RETURNING news.id, previous.status as from_status) INSERT INTO news_status_transitions (news_id, transition, from_status, to_status, transitioned_by) SELECT id, $4, from_status, $3, NULLIF($5, '') FROM updated RETURNING news_id`, transitionCondition(to))
+
+	transitionedID, err := db.execReturningNewsID(ctx, "TransitionNewsStatus", q, newsID, pq.Array(from), to, transition, transitionedBy)
+	if err != nil {
+		return false, err
+	}
+
+	return len(transitionedID) > 0, nil
+}
+
+// TransitionDueNews move news whose schedule time has come and status is one of from to status to, and record the transitions.
+// Rows locked by concurrent run are skipped, they are picked on next run. News whose unpublish_at has come is never published
+func (db *Postgre) TransitionDueNews(ctx context.Context, schedule, transition string, from []int, to int, transitionedBy string) (transitionedID []int, err error) {
+	column, ok := NEWS_SCHEDULE_COLUMNS[schedule]
+	if !ok {
+		return nil, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "TransitionDueNews",
+			Description:  "unknown schedule",
+			Trace:        schedule,
+		}.Error()
+	}
+
+	q := fmt.Sprintf(`WITH due AS (SELECT id, status FROM news WHERE %s <= now() AND deleted_at IS NULL AND status = ANY($1) AND %s FOR UPDATE SKIP LOCKED), updated AS (UPDATE news SET status = $2, updated_at = now() FROM due WHERE news.id = due.id RETURNING news.id, due.status as from_status) INSERT INTO news_status_transitions (news_id, transition, from_status, to_status, transitioned_by) SELECT id, $3, from_status, $2, NULLIF($4, '') FROM updated RETURNING news_id`, column, transitionCondition(to))
+
+	return db.execReturningNewsID(ctx, "TransitionDueNews", q, pq.Array(from), to, transition, transitionedBy)
+}
+
+// TryLockNewsSchedule take transaction level advisory lock of news schedule, false mean other instance is running it.
+// It must run inside WithTx, the lock is released when the transaction end
+func (db *Postgre) TryLockNewsSchedule(ctx context.Context) (locked bool, err error) {
+	if db.tx == nil {
+		return false, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "TryLockNewsSchedule",
+			Description:  "advisory lock need transaction",
+			Trace:        nil,
+		}.Error()
+	}
+
+	err = db.tx.QueryRowxContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, NEWS_SCHEDULE_LOCK_KEY).Scan(&locked)
+	if err != nil {
+		return false, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "TryLockNewsSchedule",
+			Description:  "failed take advisory lock",
+			Trace:        err,
+		}.Error()
+	}
+
+	return locked, nil
+}
+
+// GetNewsStatusTransitions return workflow transitions of news, oldest first
+func (db *Postgre) GetNewsStatusTransitions(ctx context.Context, newsID int) (res []presentation.NewsStatusTransition, err error) {
+	q := `SELECT id, news_id, transition, from_status, to_status, transitioned_by, transitioned_at FROM news_status_transitions WHERE news_id = $1 ORDER BY transitioned_at, id`
+
+	rows, err := db.queryRead(ctx, "GetNewsStatusTransitions", q, newsID)
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "GetNewsStatusTransitions",
+			Description:  "failed running queryx",
+			Trace:        err,
+		}.Error()
+	}
+
+	for rows.Next() {
+		var _t presentation.NewsStatusTransition
+
+		err = rows.StructScan(&_t)
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "GetNewsStatusTransitions",
+				Description:  "failed scan",
+				Trace:        err,
+			}.Error()
+		}
+
+		res = append(res, _t)
+	}
+
+	return res, nil
+}
+
+// transitionCondition return condition news must meet, beside its status, to move to status to
+func transitionCondition(to int) string {
+	if to == presentation.NEWS_STATUS_PUBLISHED {
+		return NEWS_PUBLICATION_WINDOW_OPEN
+	}
+
+	return "TRUE"
+}
@@ -0,0 +1,266 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/lib/pq"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_TransitionNewsStatus(t *testing.T) {
+	testcases := []struct {
+		name       string
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn bool
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("WITH previous AS (.+) INSERT INTO news_status_transitions (.+) RETURNING news_id").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Status Not Allowed",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("WITH previous AS (.+) INSERT INTO news_status_transitions (.+) RETURNING news_id").
+					WithArgs(1, pq.Array([]int{presentation.NEWS_STATUS_APPROVED}), presentation.NEWS_STATUS_PUBLISHED, presentation.NEWS_TRANSITION_PUBLISH, "user-1").
+					WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+			},
+			mustReturn: false,
+		},
+		{
+			name: "Success - Publish After Unpublish At Refused",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`WITH previous AS (.+) AND \(unpublish_at IS NULL OR unpublish_at > now\(\)\) FOR UPDATE(.+) INSERT INTO news_status_transitions (.+) RETURNING news_id`).
+					WithArgs(1, pq.Array([]int{presentation.NEWS_STATUS_APPROVED}), presentation.NEWS_STATUS_PUBLISHED, presentation.NEWS_TRANSITION_PUBLISH, "user-1").
+					WillReturnRows(sqlmock.NewRows([]string{"news_id"}))
+			},
+			mustReturn: false,
+		},
+		{
+			name: "Success - Transitioned",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`WITH previous AS (.+) AND \(unpublish_at IS NULL OR unpublish_at > now\(\)\) FOR UPDATE(.+) INSERT INTO news_status_transitions (.+) RETURNING news_id`).
+					WithArgs(1, pq.Array([]int{presentation.NEWS_STATUS_APPROVED}), presentation.NEWS_STATUS_PUBLISHED, presentation.NEWS_TRANSITION_PUBLISH, "user-1").
+					WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(1))
+			},
+			mustReturn: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, err := pgDB.TransitionNewsStatus(context.Background(), 1, presentation.NEWS_TRANSITION_PUBLISH, []int{presentation.NEWS_STATUS_APPROVED}, presentation.NEWS_STATUS_PUBLISHED, "user-1")
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || res != tc.mustReturn {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_TransitionNewsStatus",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_TransitionDueNews(t *testing.T) {
+	testcases := []struct {
+		name       string
+		schedule   string
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []int
+	}{
+		{
+			name:     "Failed - Unknown Schedule",
+			schedule: "created_at",
+			mockExp:  func(mm sqlmock.Sqlmock) {},
+			mustErr:  true,
+		},
+		{
+			name:     "Failed - SQL Return Error",
+			schedule: presentation.NEWS_SCHEDULE_PUBLISH,
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`WITH due AS (.+) WHERE publish_at <= now\(\) (.+) FOR UPDATE SKIP LOCKED(.+) INSERT INTO news_status_transitions (.+) RETURNING news_id`).
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name:     "Success - Publish Due News",
+			schedule: presentation.NEWS_SCHEDULE_PUBLISH,
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery(`WITH due AS (.+) WHERE publish_at <= now\(\) (.+) AND \(unpublish_at IS NULL OR unpublish_at > now\(\)\) FOR UPDATE SKIP LOCKED(.+) INSERT INTO news_status_transitions (.+) RETURNING news_id`).
+					WithArgs(pq.Array([]int{presentation.NEWS_STATUS_APPROVED}), presentation.NEWS_STATUS_PUBLISHED, presentation.NEWS_TRANSITION_PUBLISH, "scheduler").
+					WillReturnRows(sqlmock.NewRows([]string{"news_id"}).AddRow(3).AddRow(4))
+			},
+			mustReturn: []int{3, 4},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, err := pgDB.TransitionDueNews(context.Background(), tc.schedule, presentation.NEWS_TRANSITION_PUBLISH, []int{presentation.NEWS_STATUS_APPROVED}, presentation.NEWS_STATUS_PUBLISHED, "scheduler")
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_TransitionDueNews",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_TryLockNewsSchedule(t *testing.T) {
+	testcases := []struct {
+		name       string
+		inTx       bool
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn bool
+	}{
+		{
+			name:    "Failed - Outside Transaction",
+			mockExp: func(mm sqlmock.Sqlmock) {},
+			mustErr: true,
+		},
+		{
+			name: "Success - Held By Other Instance",
+			inTx: true,
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectQuery("SELECT pg_try_advisory_xact_lock").
+					WithArgs(NEWS_SCHEDULE_LOCK_KEY).
+					WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+				mm.ExpectCommit()
+			},
+			mustReturn: false,
+		},
+		{
+			name: "Success - Locked",
+			inTx: true,
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectQuery("SELECT pg_try_advisory_xact_lock").
+					WithArgs(NEWS_SCHEDULE_LOCK_KEY).
+					WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+				mm.ExpectCommit()
+			},
+			mustReturn: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+
+			var res bool
+			if tc.inTx {
+				err = pgDB.WithTx(context.Background(), func(tx *Postgre) error {
+					res, err = tx.TryLockNewsSchedule(context.Background())
+					return err
+				})
+			} else {
+				res, err = pgDB.TryLockNewsSchedule(context.Background())
+			}
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || res != tc.mustReturn || mock.ExpectationsWereMet() != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_TryLockNewsSchedule",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v, expectation %v", res, tc.mustReturn, tc.mustErr, err, mock.ExpectationsWereMet()),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_GetNewsStatusTransitions(t *testing.T) {
+	now := time.Now()
+	by := "user-1"
+
+	testcases := []struct {
+		name       string
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []presentation.NewsStatusTransition
+	}{
+		{
+			name: "Failed - SQL Return Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news_status_transitions WHERE news_id = (.+) ORDER BY transitioned_at, id").
+					WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectQuery("SELECT (.+) FROM news_status_transitions WHERE news_id = (.+) ORDER BY transitioned_at, id").
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "news_id", "transition", "from_status", "to_status", "transitioned_by", "transitioned_at"}).
+						AddRow(1, 1, presentation.NEWS_TRANSITION_SUBMIT, presentation.NEWS_STATUS_DRAFT, presentation.NEWS_STATUS_IN_REVIEW, by, now).
+						AddRow(2, 1, presentation.NEWS_TRANSITION_APPROVE, presentation.NEWS_STATUS_IN_REVIEW, presentation.NEWS_STATUS_APPROVED, nil, now))
+			},
+			mustReturn: []presentation.NewsStatusTransition{
+				{ID: 1, NewsID: 1, Transition: presentation.NEWS_TRANSITION_SUBMIT, FromStatus: presentation.NEWS_STATUS_DRAFT, ToStatus: presentation.NEWS_STATUS_IN_REVIEW, TransitionedBy: &by, TransitionedAt: now},
+				{ID: 2, NewsID: 1, Transition: presentation.NEWS_TRANSITION_APPROVE, FromStatus: presentation.NEWS_STATUS_IN_REVIEW, ToStatus: presentation.NEWS_STATUS_APPROVED, TransitionedAt: now},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+			res, err := pgDB.GetNewsStatusTransitions(context.Background(), 1)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GetNewsStatusTransitions",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package postgre
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"io"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// DEFAULT_SLAVE_RETRY_INTERVAL used when ReadOptions.SlaveRetryInterval is not set
+const DEFAULT_SLAVE_RETRY_INTERVAL = time.Duration(30) * time.Second
+
+func (pg *PgDB) slaveHealthy(slave *sqlx.DB) bool {
+	downAt, ok := pg.slaveDownAt.Load(slave)
+	if !ok {
+		return true
+	}
+
+	retryInterval := pg.ReadOptions.SlaveRetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DEFAULT_SLAVE_RETRY_INTERVAL
+	}
+
+	if time.Since(downAt.(time.Time)) < retryInterval {
+		return false
+	}
+
+	// Retry interval passed, give it another chance
+	pg.slaveDownAt.Delete(slave)
+	return true
+}
+
+// reader pick next healthy slave in round-robin, and return Master when no slave available
+func (pg *PgDB) reader() *sqlx.DB {
+	if len(pg.Slaves) == 0 {
+		return pg.Master
+	}
+
+	start := atomic.AddUint32(&pg.readCounter, 1)
+	for i := 0; i < len(pg.Slaves); i++ {
+		slave := pg.Slaves[(int(start)+i)%len(pg.Slaves)]
+		if pg.slaveHealthy(slave) {
+			return slave
+		}
+	}
+
+	return pg.Master
+}
+
+// connectionError report whether err come from unreachable or unusable server, not from the query itself
+func connectionError(err error) bool {
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &netErr) {
+		return true
+	}
+
+	// Class 08 is connection exception, 57P01-57P03 is server shutting down or not accepting connection yet
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code.Class() == "08" || pqErr.Code == "57P01" || pqErr.Code == "57P02" || pqErr.Code == "57P03"
+	}
+
+	return false
+}
+
+// queryRead run read only query on slave, slave failing on connection level marked down and the query retried on Master.
+// Query of ctx from dbutils.WithPrimaryRead always run on Master
+func (db *Postgre) queryRead(ctx context.Context, functionName, q string, args ...interface{}) (*sqlx.Rows, error) {
+	if db.tx != nil {
+		return db.tx.QueryxContext(ctx, q, args...)
+	}
+
+	conn := db.newsDatabase.Master
+	if !dbutils.PrimaryRead(ctx) {
+		conn = db.newsDatabase.reader()
+	}
+
+	rows, err := conn.QueryxContext(ctx, q, args...)
+	if err == nil || conn == db.newsDatabase.Master || ctx.Err() != nil || !connectionError(err) {
+		// Cancelled request or error of the query itself is not slave fault, do not mark it down
+		return rows, err
+	}
+
+	db.newsDatabase.slaveDownAt.Store(conn, time.Now())
+	logger.Error(response.InternalError{
+		Type:         "Repo",
+		Name:         "Postgre",
+		FunctionName: functionName,
+		Description:  "slave query failed, fallback to master",
+		Trace:        err,
+	}.Error())
+
+	return db.newsDatabase.Master.QueryxContext(ctx, q, args...)
+}
@@ -0,0 +1,199 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mufidzz/bareksa-test/pkg/dbutils"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func Test_ReadRouting(t *testing.T) {
+	newsColumns := []string{"id", "created_at", "updated_at", "title", "content", "topics_name", "tags_name", "status"}
+	pagination := presentation.Pagination{Offset: 0, Count: 5}
+	now := time.Now()
+	connRefused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+
+	testcases := []struct {
+		name    string
+		run     func(pg *Postgre) error
+		mockExp func(master, slave sqlmock.Sqlmock)
+		mustErr bool
+	}{
+		{
+			name: "Success - Read Go To Slave",
+			run: func(pg *Postgre) error {
+				_, _, err := pg.GetBulkNews(context.Background(), pagination, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				slave.ExpectQuery("SELECT (.+) FROM news").
+					WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(1, now, now, "a", "b", "", "", 1))
+			},
+		},
+		{
+			name: "Success - Slave Error Fallback To Master",
+			run: func(pg *Postgre) error {
+				_, _, err := pg.GetBulkNewsTopics(context.Background(), nil, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				slave.ExpectQuery("SELECT (.+) FROM news_topics").
+					WillReturnError(connRefused)
+				master.ExpectQuery("SELECT (.+) FROM news_topics").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a"))
+			},
+		},
+		{
+			name: "Success - Slave Marked Down Skipped",
+			run: func(pg *Postgre) error {
+				_, _, err := pg.GetBulkNewsTags(context.Background(), nil, nil, "")
+				if err != nil {
+					return err
+				}
+
+				_, _, err = pg.GetBulkNewsTags(context.Background(), nil, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				slave.ExpectQuery("SELECT (.+) FROM news_tags").
+					WillReturnError(connRefused)
+				master.ExpectQuery("SELECT (.+) FROM news_tags").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a"))
+				master.ExpectQuery("SELECT (.+) FROM news_tags").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a"))
+			},
+		},
+		{
+			name: "Success - Read After Write Stay On Slave",
+			run: func(pg *Postgre) error {
+				_, err := pg.DeleteBulkNews(context.Background(), []int{1})
+				if err != nil {
+					return err
+				}
+
+				_, _, err = pg.GetBulkNews(context.Background(), pagination, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				master.ExpectQuery("UPDATE news SET").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				slave.ExpectQuery("SELECT (.+) FROM news").
+					WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(1, now, now, "a", "b", "", "", 1))
+			},
+		},
+		{
+			name: "Success - Primary Read Go To Master",
+			run: func(pg *Postgre) error {
+				_, _, err := pg.GetBulkNews(dbutils.WithPrimaryRead(context.Background()), pagination, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				master.ExpectQuery("SELECT (.+) FROM news").
+					WillReturnRows(sqlmock.NewRows(newsColumns).AddRow(1, now, now, "a", "b", "", "", 1))
+			},
+		},
+		{
+			name: "Success - Query Error Not Mark Slave Down",
+			run: func(pg *Postgre) error {
+				_, _, err := pg.GetBulkNewsTags(context.Background(), nil, nil, "")
+				if err == nil {
+					return fmt.Errorf("expected query error")
+				}
+
+				_, _, err = pg.GetBulkNewsTags(context.Background(), nil, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				slave.ExpectQuery("SELECT (.+) FROM news_tags").
+					WillReturnError(&pq.Error{Code: "42P01", Message: "relation does not exist"})
+				slave.ExpectQuery("SELECT (.+) FROM news_tags").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a"))
+			},
+		},
+		{
+			name: "Failed - Cancelled Request Not Retried On Master",
+			run: func(pg *Postgre) error {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+
+				_, _, err := pg.GetBulkNews(ctx, pagination, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Slave And Master Error",
+			run: func(pg *Postgre) error {
+				_, _, err := pg.GetBulkNews(context.Background(), pagination, nil, "")
+				return err
+			},
+			mockExp: func(master, slave sqlmock.Sqlmock) {
+				slave.ExpectQuery("SELECT (.+) FROM news").
+					WillReturnError(connRefused)
+				master.ExpectQuery("SELECT (.+) FROM news").
+					WillReturnError(connRefused)
+			},
+			mustErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			masterDB, masterMock, err := sqlmock.New()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer masterDB.Close()
+
+			slaveDB, slaveMock, err := sqlmock.New()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer slaveDB.Close()
+
+			pg := NewWithDBObject(&PgDB{
+				Master: sqlx.NewDb(masterDB, DB_DRIVER_NAME_SQLMOCK),
+				Slaves: []*sqlx.DB{sqlx.NewDb(slaveDB, DB_DRIVER_NAME_SQLMOCK)},
+			})
+
+			tc.mockExp(masterMock, slaveMock)
+			err = tc.run(pg)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_ReadRouting",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := masterMock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_ReadRouting",
+					Description:  "Master expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+
+			if err := slaveMock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_ReadRouting",
+					Description:  "Slave expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
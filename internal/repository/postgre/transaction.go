@@ -0,0 +1,83 @@
+package postgre
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/jmoiron/sqlx"
+)
+
+// executor is implemented by both *sqlx.DB and *sqlx.Tx, so repository methods run the same inside or outside transaction
+type executor interface {
+	QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// writer return executor for write query, the running transaction if any or Master
+func (db *Postgre) writer() executor {
+	if db.tx != nil {
+		return db.tx
+	}
+
+	return db.newsDatabase.Master
+}
+
+// WithTx run fn inside single transaction on Master, every repository method called on tx take part in it.
+// Transaction is committed when fn return nil, and rolled back when fn return error or panic
+func (db *Postgre) WithTx(ctx context.Context, fn func(tx *Postgre) error) (err error) {
+	if db.tx != nil {
+		// Already inside transaction, join it instead of opening nested one
+		return fn(db)
+	}
+
+	sqlTx, err := db.newsDatabase.Master.BeginTxx(ctx, nil)
+	if err != nil {
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "WithTx",
+			Description:  "failed begin transaction",
+			Trace:        err,
+		}.Error()
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = sqlTx.Rollback()
+			panic(p)
+		}
+	}()
+
+	err = fn(&Postgre{
+		newsDatabase: db.newsDatabase,
+		tx:           sqlTx,
+	})
+	if err != nil {
+		rollbackErr := sqlTx.Rollback()
+		if rollbackErr != nil {
+			return response.InternalError{
+				Type:         "Repo",
+				Name:         "Postgre",
+				FunctionName: "WithTx",
+				Description:  "failed rollback transaction",
+				Trace:        fmt.Errorf("%v, rollback trace %v", err, rollbackErr),
+			}.Error()
+		}
+
+		return err
+	}
+
+	err = sqlTx.Commit()
+	if err != nil {
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Postgre",
+			FunctionName: "WithTx",
+			Description:  "failed commit transaction",
+			Trace:        err,
+		}.Error()
+	}
+
+	return nil
+}
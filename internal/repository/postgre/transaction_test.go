@@ -0,0 +1,160 @@
+package postgre
+
+import (
+	"context"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/Mufidzz/bareksa-test/presentation"
+	"github.com/lib/pq"
+	"testing"
+)
+
+func Test_WithTx(t *testing.T) {
+	testcases := []struct {
+		name      string
+		mockExp   func(mm sqlmock.Sqlmock)
+		fn        func(tx *Postgre) error
+		mustErr   bool
+		mustPanic bool
+	}{
+		{
+			name: "Failed - Begin Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin().WillReturnError(fmt.Errorf("hello"))
+			},
+			fn: func(tx *Postgre) error {
+				return nil
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Rollback When Second Statement Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectQuery("UPDATE news SET (.+) WHERE (.+) RETURNING id").
+					WithArgs(presentation.NEWS_STATUS_DELETED, pq.Array([]int{1})).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mm.ExpectExec("DELETE FROM assoc_news_topics WHERE (.+)").
+					WithArgs(pq.Array([]int{1})).
+					WillReturnError(fmt.Errorf("hello"))
+				mm.ExpectRollback()
+			},
+			fn: func(tx *Postgre) error {
+				_, err := tx.DeleteBulkNews(context.Background(), []int{1})
+				if err != nil {
+					return err
+				}
+
+				err = tx.CleanNewsTopicsAssoc(context.Background(), []int{1})
+				if err != nil {
+					return err
+				}
+
+				return tx.CleanNewsTagAssoc(context.Background(), []int{1})
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Rollback Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectExec("DELETE FROM assoc_news_tags WHERE (.+)").
+					WillReturnError(fmt.Errorf("hello"))
+				mm.ExpectRollback().WillReturnError(fmt.Errorf("connection lost"))
+			},
+			fn: func(tx *Postgre) error {
+				return tx.CleanNewsTagAssoc(context.Background(), []int{1})
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Rollback On Panic",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectRollback()
+			},
+			fn: func(tx *Postgre) error {
+				panic("hello")
+			},
+			mustPanic: true,
+		},
+		{
+			name: "Failed - Commit Error",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectExec("DELETE FROM assoc_news_tags WHERE (.+)").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mm.ExpectCommit().WillReturnError(fmt.Errorf("hello"))
+			},
+			fn: func(tx *Postgre) error {
+				return tx.CleanNewsTagAssoc(context.Background(), []int{1})
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Commit All Statements",
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectBegin()
+				mm.ExpectQuery("INSERT INTO news (.+) VALUES (.+) RETURNING (.+)INSERT INTO news_revisions (.+) SELECT id FROM inserted").
+					WithArgs("A", "B", 1, "", nil, nil).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mm.ExpectExec("INSERT INTO assoc_news_topics (.+) VALUES (.+)").
+					WithArgs(7, 1, 7, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mm.ExpectCommit()
+			},
+			fn: func(tx *Postgre) error {
+				insertedID, err := tx.CreateBulkNews(context.Background(), []presentation.CreateNewsRequest{{Title: "A", Content: "B", Status: 1}})
+				if err != nil {
+					return err
+				}
+
+				return tx.WithTx(context.Background(), func(nested *Postgre) error {
+					return nested.CreateBulkNewsTopicsAssoc(context.Background(), []presentation.CreateNewsTopicsAssoc{{NewsID: insertedID[0], NewsTopicsID: []int{1, 2}}})
+				})
+			},
+			mustErr: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			pgDB, db, mock, err := initDB()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			tc.mockExp(mock)
+
+			panicked := false
+			func() {
+				defer func() {
+					if recover() != nil {
+						panicked = true
+					}
+				}()
+				err = pgDB.WithTx(context.Background(), tc.fn)
+			}()
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || tc.mustPanic != panicked {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_WithTx",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("mustErr %v, err %v, mustPanic %v, panicked %v", tc.mustErr, err, tc.mustPanic, panicked),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_WithTx",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/go-redis/redis/v8"
+)
+
+// invalidateTagsScript delete every member of given tag sets together with the sets, in one step so key tagged meanwhile is not lost
+var invalidateTagsScript = redis.NewScript(`
+for _, tagKey in ipairs(KEYS) do
+	for _, key in ipairs(redis.call('SMEMBERS', tagKey)) do
+		redis.call('DEL', key)
+	end
+	redis.call('DEL', tagKey)
+end
+return #KEYS
+`)
+
+func tagSetKey(tag string) string {
+	return fmt.Sprintf(REDIS_CACHE_TAG_KEY, tag)
+}
+
+// tagKey add key into every tag set, tag set expiry is renewed so it always outlive its members
+func (redis *Redis) tagKey(ctx context.Context, key string, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	pipe := redis.newsClient.TxPipeline()
+	for _, tag := range tags {
+		pipe.SAdd(ctx, tagSetKey(tag), key)
+		pipe.Expire(ctx, tagSetKey(tag), REDIS_STALE_TIMEOUT_NEWS)
+	}
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		redis.stats.Error()
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Redis",
+			FunctionName: "tagKey",
+			Description:  "Failed to tag key",
+			Trace:        err,
+		}.Error()
+	}
+
+	return nil
+}
+
+// InvalidateTags delete every cache key tracked under any of the tags, keys outside the tags are untouched
+func (redis *Redis) InvalidateTags(ctx context.Context, tags ...string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	tagKeys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagKeys = append(tagKeys, tagSetKey(tag))
+	}
+
+	err := invalidateTagsScript.Run(ctx, redis.newsClient, tagKeys).Err()
+	if err != nil {
+		redis.stats.Error()
+		return response.InternalError{
+			Type:         "Repo",
+			Name:         "Redis",
+			FunctionName: "InvalidateTags",
+			Description:  "Failed to invalidate tags",
+			Trace:        err,
+		}.Error()
+	}
+
+	return nil
+}
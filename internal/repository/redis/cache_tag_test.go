@@ -0,0 +1,169 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/alicebob/miniredis"
+	"github.com/go-redis/redis/v8"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func Test_InvalidateTags(t *testing.T) {
+	testcases := []struct {
+		name       string
+		saved      map[string][]string
+		others     []string
+		invalidate []string
+		mustRemain []string
+		mustErr    bool
+	}{
+		{
+			name: "Success - Only Tagged Keys Evicted",
+			saved: map[string][]string{
+				"news:single:1": {"news:1", "news-topic:1"},
+				"news:single:2": {"news:2"},
+				"news:list:a":   {"news:list", "news-topic:1"},
+			},
+			others:     []string{"other:app:key"},
+			invalidate: []string{"news:1"},
+			mustRemain: []string{"news:list:a", "news:single:2", "other:app:key"},
+		},
+		{
+			name: "Success - Multiple Tags",
+			saved: map[string][]string{
+				"news:single:1": {"news:1", "news-topic:1"},
+				"news:single:2": {"news:2"},
+				"news:list:a":   {"news:list"},
+			},
+			invalidate: []string{"news:list", "news-topic:1"},
+			mustRemain: []string{"news:single:2"},
+		},
+		{
+			name: "Success - Unknown Tag",
+			saved: map[string][]string{
+				"news:single:1": {"news:1"},
+			},
+			invalidate: []string{"news:99"},
+			mustRemain: []string{"news:single:1"},
+		},
+		{
+			name: "Success - Untagged Key Kept",
+			saved: map[string][]string{
+				"news:single:1": nil,
+			},
+			invalidate: []string{"news:1"},
+			mustRemain: []string{"news:single:1"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			mr, err := miniredis.Run()
+			if err != nil {
+				tt.Fatalf("Error on Initalize miniredis, trace %v", err)
+			}
+			defer mr.Close()
+
+			r := NewFromObject(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+			ctx := context.Background()
+
+			for key, tags := range tc.saved {
+				err = r.SaveObject(ctx, key, "A", tags...)
+				if err != nil {
+					tt.Fatalf("Error on SaveObject, trace %v", err)
+				}
+			}
+
+			for _, key := range tc.others {
+				_ = mr.Set(key, "B")
+			}
+
+			err = r.InvalidateTags(ctx, tc.invalidate...)
+
+			var remain []string
+			for _, key := range mr.Keys() {
+				if mr.Type(key) == "string" {
+					remain = append(remain, key)
+				}
+			}
+			sort.Strings(remain)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(remain, tc.mustRemain) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_InvalidateTags",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", remain, tc.mustRemain, tc.mustErr, err),
+				}.Error())
+			}
+
+			for _, tag := range tc.invalidate {
+				if mr.Exists(tagSetKey(tag)) {
+					tt.Error(response.InternalTestError{
+						Name:         tt.Name(),
+						FunctionName: "Test_InvalidateTags",
+						Description:  "Tag set not removed",
+						Trace:        tag,
+					}.Error())
+				}
+			}
+		})
+	}
+}
+
+func Test_SaveObjectTagExpiry(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Error on Initalize miniredis, trace %v", err)
+	}
+	defer mr.Close()
+
+	r := NewFromObject(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+
+	err = r.SaveObject(context.Background(), "news:single:1", "A", "news:1")
+	if err != nil || mr.TTL(tagSetKey("news:1")) != REDIS_STALE_TIMEOUT_NEWS {
+		t.Error(response.InternalTestError{
+			Name:         t.Name(),
+			FunctionName: "Test_SaveObjectTagExpiry",
+			Description:  "Tag set expiry not set",
+			Trace:        fmt.Sprintf("ttl %v, err %v", mr.TTL(tagSetKey("news:1")), err),
+		}.Error())
+	}
+}
+
+func Test_Stats(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("Error on Initalize miniredis, trace %v", err)
+	}
+	defer mr.Close()
+
+	r := NewFromObject(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	ctx := context.Background()
+
+	var dest string
+	_ = r.SaveObject(ctx, "hit", "A")
+	_ = mr.Set("corrupt", "{")
+
+	_, _ = r.GetObject(ctx, "hit", &dest)
+	_, _ = r.GetObject(ctx, "hit", &dest)
+	_, _ = r.GetObject(ctx, "miss", &dest)
+	_, _ = r.GetObject(ctx, "corrupt", &dest)
+
+	mr.Close()
+	_, _ = r.GetObject(ctx, "hit", &dest)
+
+	mustStats := cache.StatsSnapshot{Hits: 2, Misses: 1, Errors: 2}
+	if r.Stats() != mustStats {
+		t.Error(response.InternalTestError{
+			Name:         t.Name(),
+			FunctionName: "Test_Stats",
+			Description:  "Testcase run unsuccessfully",
+			Trace:        fmt.Sprintf("got %+v, expected %+v", r.Stats(), mustStats),
+		}.Error())
+	}
+}
@@ -2,4 +2,11 @@ package redis
 
 import "time"
 
+// REDIS_TIMEOUT_NEWS is soft TTL, object older than it is returned as stale
 const REDIS_TIMEOUT_NEWS = time.Duration(30) * time.Second
+
+// REDIS_STALE_TIMEOUT_NEWS is hard TTL, object is removed from Redis after it
+const REDIS_STALE_TIMEOUT_NEWS = time.Duration(5) * time.Minute
+
+// REDIS_CACHE_TAG_KEY is set holding every cache key depending on the tag
+const REDIS_CACHE_TAG_KEY = "cache-tag:%s"
@@ -2,12 +2,16 @@ package redis
 
 import (
 	"context"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/go-redis/redis/v8"
 )
 
 type Redis struct {
 	newsClient *redis.Client
+
+	// stats count GetObject hits and misses, and failure of every command
+	stats cache.Stats
 }
 
 func New(newsRedisOption redis.Options) (*Redis, error) {
@@ -35,3 +39,8 @@ func NewFromObject(newsRedisClient *redis.Client) *Redis {
 		newsClient: newsRedisClient,
 	}
 }
+
+// Stats return cache hit, miss and error counters since start
+func (redis *Redis) Stats() cache.StatsSnapshot {
+	return redis.stats.Snapshot()
+}
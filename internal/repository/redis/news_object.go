@@ -3,28 +3,68 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
+	goredis "github.com/go-redis/redis/v8"
+	"time"
 )
 
-func (redis *Redis) GetObject(key string, dest interface{}) error {
-	objectJson := redis.newsClient.Get(context.Background(), key)
+// cacheEntry wrap saved object with its soft expiry, Redis TTL itself is the hard expiry
+type cacheEntry struct {
+	Value      json.RawMessage `json:"value"`
+	FreshUntil time.Time       `json:"fresh_until"`
+}
+
+// GetObject read object saved by SaveObject into dest, stale is true when the object passed its soft TTL but not yet removed.
+// Missing key return cache.ErrCacheMiss, failures wrap cache.ErrCacheUnavailable or cache.ErrCacheCorrupt
+func (redis *Redis) GetObject(ctx context.Context, key string, dest interface{}) (stale bool, err error) {
+	objectJson := redis.newsClient.Get(ctx, key)
+	if objectJson.Err() == goredis.Nil {
+		redis.stats.Miss()
+		return false, cache.ErrCacheMiss
+	}
+
+	if objectJson.Err() != nil {
+		redis.stats.Error()
+		return false, response.InternalError{
+			Type:         "Repo",
+			Name:         "Redis",
+			FunctionName: "GetObject",
+			Description:  "Failed to Get from database",
+			Trace:        fmt.Errorf("%w: %v", cache.ErrCacheUnavailable, objectJson.Err()),
+		}.Error()
+	}
+
+	var entry cacheEntry
+	err = json.Unmarshal([]byte(objectJson.Val()), &entry)
+	if err == nil && len(entry.Value) == 0 {
+		err = fmt.Errorf("object has no value")
+	}
+
+	if err == nil {
+		err = json.Unmarshal(entry.Value, &dest)
+	}
 
-	err := json.Unmarshal([]byte(objectJson.Val()), &dest)
 	if err != nil {
-		return response.InternalError{
+		redis.stats.Error()
+		return false, response.InternalError{
 			Type:         "Repo",
 			Name:         "Redis",
 			FunctionName: "GetObject",
 			Description:  "Failed Unmarshal JSON",
-			Trace:        err,
+			Trace:        fmt.Errorf("%w: %v", cache.ErrCacheCorrupt, err),
 		}.Error()
 	}
 
-	return nil
+	redis.stats.Hit()
+	return time.Now().After(entry.FreshUntil), nil
 }
 
-func (redis *Redis) SaveObject(key string, value interface{}) error {
-	objectJson, err := json.Marshal(value)
+// SaveObject store value as JSON under key, fresh for REDIS_TIMEOUT_NEWS and kept as stale until REDIS_STALE_TIMEOUT_NEWS.
+// The key is tracked under every given tag so InvalidateTags can evict it
+func (redis *Redis) SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error {
+	valueJson, err := json.Marshal(value)
 	if err != nil {
 		return response.InternalError{
 			Type:         "Repo",
@@ -35,28 +75,34 @@ func (redis *Redis) SaveObject(key string, value interface{}) error {
 		}.Error()
 	}
 
-	res := redis.newsClient.Set(context.Background(), key, objectJson, REDIS_TIMEOUT_NEWS)
-	if res.Err() != nil {
+	objectJson, err := json.Marshal(cacheEntry{
+		Value:      valueJson,
+		FreshUntil: time.Now().Add(REDIS_TIMEOUT_NEWS),
+	})
+	if err != nil {
 		return response.InternalError{
 			Type:         "Repo",
 			Name:         "Redis",
 			FunctionName: "SaveObject",
-			Description:  "Failed to Set into database",
-			Trace:        res.Err(),
+			Description:  "Failed Marshall JSON",
+			Trace:        err,
 		}.Error()
 	}
 
-	return nil
-}
+	// Tag before set, a key stored without its tags could not be invalidated
+	err = redis.tagKey(ctx, key, tags)
+	if err != nil {
+		return err
+	}
 
-func (redis *Redis) FlushAll() error {
-	res := redis.newsClient.FlushAll(context.Background())
+	res := redis.newsClient.Set(ctx, key, objectJson, REDIS_STALE_TIMEOUT_NEWS)
 	if res.Err() != nil {
+		redis.stats.Error()
 		return response.InternalError{
 			Type:         "Repo",
 			Name:         "Redis",
-			FunctionName: "FlushAll",
-			Description:  "Failed to Flush database",
+			FunctionName: "SaveObject",
+			Description:  "Failed to Set into database",
 			Trace:        res.Err(),
 		}.Error()
 	}
@@ -1,16 +1,20 @@
 package redis
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/cache"
 	"github.com/Mufidzz/bareksa-test/pkg/response"
 	"github.com/go-redis/redismock/v8"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func Test_SaveObject(t *testing.T) {
-	exp := REDIS_TIMEOUT_NEWS
+	exp := REDIS_STALE_TIMEOUT_NEWS
 
 	testcases := []struct {
 		name    string
@@ -24,8 +28,21 @@ func Test_SaveObject(t *testing.T) {
 			key:   "A",
 			value: "B",
 			mock: func(mock redismock.ClientMock) {
-				objectJson, _ := json.Marshal("B")
-				mock.ExpectSet("A", objectJson, exp).
+				mock.CustomMatch(func(expected, actual []interface{}) error {
+					var entry cacheEntry
+					objectJson, _ := actual[2].([]byte)
+					err := json.Unmarshal(objectJson, &entry)
+					if err != nil || string(entry.Value) != `"B"` || time.Until(entry.FreshUntil) > REDIS_TIMEOUT_NEWS || time.Until(entry.FreshUntil) <= 0 {
+						return fmt.Errorf("unexpected entry %s, trace %v", objectJson, err)
+					}
+
+					expected[2] = actual[2]
+					if !reflect.DeepEqual(expected, actual) {
+						return fmt.Errorf("expectation %v, but gave %v", expected, actual)
+					}
+
+					return nil
+				}).ExpectSet("A", nil, exp).
 					SetVal("")
 			},
 
@@ -59,7 +76,7 @@ func Test_SaveObject(t *testing.T) {
 			tc.mock(mock)
 
 			r := NewFromObject(db)
-			err := r.SaveObject(tc.key, tc.value)
+			err := r.SaveObject(context.Background(), tc.key, tc.value)
 
 			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
 				tt.Error(response.InternalTestError{
@@ -79,6 +96,8 @@ func Test_GetObject(t *testing.T) {
 		key        string
 		mock       func(redismock.ClientMock)
 		mustErr    bool
+		mustErrIs  error
+		mustStale  bool
 		mustReturn string
 	}{
 		{
@@ -86,11 +105,44 @@ func Test_GetObject(t *testing.T) {
 			key:  "A",
 			mock: func(mock redismock.ClientMock) {
 				mock.ExpectGet("A").
-					SetVal(`{"name" : 4}`)
+					SetVal(fmt.Sprintf(`{"value" : {"name" : 4}, "fresh_until" : "%s"}`, time.Now().Add(time.Minute).Format(time.RFC3339)))
+			},
+			mustReturn: `{"name":4}`,
+			mustErr:    false,
+		},
+		{
+			name: "Success - Stale",
+			key:  "A",
+			mock: func(mock redismock.ClientMock) {
+				mock.ExpectGet("A").
+					SetVal(fmt.Sprintf(`{"value" : {"name" : 4}, "fresh_until" : "%s"}`, time.Now().Add(-time.Minute).Format(time.RFC3339)))
 			},
 			mustReturn: `{"name":4}`,
+			mustStale:  true,
 			mustErr:    false,
 		},
+		{
+			name: "Failed - Miss",
+			key:  "A",
+			mock: func(mock redismock.ClientMock) {
+				mock.ExpectGet("A").
+					RedisNil()
+			},
+			mustReturn: "null",
+			mustErr:    true,
+			mustErrIs:  cache.ErrCacheMiss,
+		},
+		{
+			name: "Failed - Saved Without Entry",
+			key:  "A",
+			mock: func(mock redismock.ClientMock) {
+				mock.ExpectGet("A").
+					SetVal(`{"name" : 4}`)
+			},
+			mustReturn: "null",
+			mustErr:    true,
+			mustErrIs:  cache.ErrCacheCorrupt,
+		},
 		{
 			name: "Failed",
 			key:  "A",
@@ -100,6 +152,7 @@ func Test_GetObject(t *testing.T) {
 			},
 			mustReturn: "null",
 			mustErr:    true,
+			mustErrIs:  cache.ErrCacheUnavailable,
 		},
 		{
 			name: "Failed - Cant Bind JSON",
@@ -110,6 +163,7 @@ func Test_GetObject(t *testing.T) {
 			},
 			mustReturn: "null",
 			mustErr:    true,
+			mustErrIs:  cache.ErrCacheCorrupt,
 		},
 	}
 	for _, tc := range testcases {
@@ -121,7 +175,7 @@ func Test_GetObject(t *testing.T) {
 
 			var jsonRes map[string]interface{}
 
-			err := r.GetObject(tc.key, &jsonRes)
+			stale, err := r.GetObject(context.Background(), tc.key, &jsonRes)
 
 			jsonResString, _ := json.Marshal(jsonRes)
 
@@ -130,51 +184,7 @@ func Test_GetObject(t *testing.T) {
 			fmt.Println(string(jsonResString))
 			fmt.Println(tc.mustReturn)
 
-			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual([]byte(tc.mustReturn), jsonResString) {
-				tt.Error(response.InternalTestError{
-					Name:         tc.name,
-					FunctionName: "Test_SaveObject",
-					Description:  "Failed Running Testcase",
-					Trace:        err,
-				})
-			}
-		})
-	}
-}
-
-func Test_FlushAll(t *testing.T) {
-	testcases := []struct {
-		name    string
-		mock    func(redismock.ClientMock)
-		mustErr bool
-	}{
-		{
-			name: "Success",
-			mock: func(mock redismock.ClientMock) {
-				mock.ExpectFlushAll().
-					SetVal("")
-			},
-			mustErr: false,
-		},
-		{
-			name: "Failed",
-			mock: func(mock redismock.ClientMock) {
-				mock.ExpectFlushAll().
-					SetErr(fmt.Errorf("FFF"))
-			},
-			mustErr: true,
-		},
-	}
-	for _, tc := range testcases {
-		t.Run(tc.name, func(tt *testing.T) {
-			db, mock := redismock.NewClientMock()
-			tc.mock(mock)
-
-			r := NewFromObject(db)
-
-			err := r.FlushAll()
-
-			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+			if ((tc.mustErr && err == nil) || (!tc.mustErr && err != nil)) || !reflect.DeepEqual([]byte(tc.mustReturn), jsonResString) || stale != tc.mustStale || (tc.mustErrIs != nil && !errors.Is(err, tc.mustErrIs)) {
 				tt.Error(response.InternalTestError{
 					Name:         tc.name,
 					FunctionName: "Test_SaveObject",
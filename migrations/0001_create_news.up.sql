@@ -0,0 +1,11 @@
+CREATE TABLE news
+(
+    id         SERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    title      TEXT        NOT NULL,
+    content    TEXT        NOT NULL,
+    status     INT         NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_news_status ON news (status);
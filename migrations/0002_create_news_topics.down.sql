@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS assoc_news_topics;
+DROP TABLE IF EXISTS news_topics;
@@ -0,0 +1,14 @@
+CREATE TABLE news_topics
+(
+    id   SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE assoc_news_topics
+(
+    news_id       INT NOT NULL REFERENCES news (id) ON DELETE CASCADE,
+    news_topic_id INT NOT NULL REFERENCES news_topics (id) ON DELETE CASCADE,
+    PRIMARY KEY (news_id, news_topic_id)
+);
+
+CREATE INDEX idx_assoc_news_topics_news_topic_id ON assoc_news_topics (news_topic_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS assoc_news_tags;
+DROP TABLE IF EXISTS news_tags;
@@ -0,0 +1,14 @@
+CREATE TABLE news_tags
+(
+    id   SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE assoc_news_tags
+(
+    news_id     INT NOT NULL REFERENCES news (id) ON DELETE CASCADE,
+    news_tag_id INT NOT NULL REFERENCES news_tags (id) ON DELETE CASCADE,
+    PRIMARY KEY (news_id, news_tag_id)
+);
+
+CREATE INDEX idx_assoc_news_tags_news_tag_id ON assoc_news_tags (news_tag_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_news_deleted_at;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS status_before_delete,
+    DROP COLUMN IF EXISTS deleted_at;
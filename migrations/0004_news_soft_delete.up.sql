@@ -0,0 +1,5 @@
+ALTER TABLE news
+    ADD COLUMN deleted_at           TIMESTAMPTZ NULL,
+    ADD COLUMN status_before_delete INT         NULL;
+
+CREATE INDEX idx_news_deleted_at ON news (deleted_at) WHERE deleted_at IS NOT NULL;
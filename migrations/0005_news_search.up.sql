@@ -0,0 +1,8 @@
+-- Title is weighted above content, 'simple' config keep words as written since news is not single language
+ALTER TABLE news
+    ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(content, '')), 'B')
+    ) STORED;
+
+CREATE INDEX idx_news_search_vector ON news USING GIN (search_vector);
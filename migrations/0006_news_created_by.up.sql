@@ -0,0 +1,5 @@
+-- Subject of the token which created the news, NULL for news created before authentication or while it is disabled
+ALTER TABLE news
+    ADD COLUMN created_by TEXT NULL;
+
+CREATE INDEX idx_news_created_by ON news (created_by);
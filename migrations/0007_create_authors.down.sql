@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS assoc_news_authors;
+DROP TABLE IF EXISTS authors;
@@ -0,0 +1,17 @@
+-- Author names are not unique, two contributors can share a name
+CREATE TABLE authors
+(
+    id   SERIAL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE TABLE assoc_news_authors
+(
+    news_id   INT NOT NULL REFERENCES news (id) ON DELETE CASCADE,
+    author_id INT NOT NULL REFERENCES authors (id) ON DELETE CASCADE,
+    -- byline order of the author on the news, lowest first
+    position  INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (news_id, author_id)
+);
+
+CREATE INDEX idx_assoc_news_authors_author_id ON assoc_news_authors (author_id);
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS news_status_transitions;
+
+ALTER TABLE news
+    DROP CONSTRAINT IF EXISTS chk_news_status;
+
+-- Statuses unknown before the workflow, in review and approved go back to draft and archived is unpublished news
+UPDATE news SET status = 1 WHERE status IN (4, 5, 6);
+UPDATE news SET status_before_delete = 1 WHERE status_before_delete IN (4, 5, 6);
@@ -0,0 +1,21 @@
+-- Status outside the workflow could be set through news update before, they are moved back to draft
+UPDATE news SET status = 1 WHERE status NOT IN (1, 2, 3, 4, 5, 6);
+UPDATE news SET status_before_delete = 1 WHERE status_before_delete NOT IN (1, 2, 4, 5, 6);
+
+ALTER TABLE news
+    ADD CONSTRAINT chk_news_status CHECK (status IN (1, 2, 3, 4, 5, 6));
+
+-- Every workflow transition of news, who made it and when
+CREATE TABLE news_status_transitions
+(
+    id              BIGSERIAL PRIMARY KEY,
+    news_id         INT         NOT NULL REFERENCES news (id) ON DELETE CASCADE,
+    transition      TEXT        NOT NULL,
+    from_status     INT         NOT NULL,
+    to_status       INT         NOT NULL,
+    -- subject of the token which made the transition, NULL while authentication is disabled
+    transitioned_by TEXT        NULL,
+    transitioned_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_news_status_transitions_news_id ON news_status_transitions (news_id, transitioned_at);
@@ -0,0 +1,4 @@
+ALTER TABLE news
+    DROP CONSTRAINT IF EXISTS chk_news_schedule,
+    DROP COLUMN IF EXISTS publish_at,
+    DROP COLUMN IF EXISTS unpublish_at;
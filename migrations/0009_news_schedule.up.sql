@@ -0,0 +1,9 @@
+-- publish_at is when approved news go live, unpublish_at when published news is taken down. Public readers never see
+-- news before publish_at or after unpublish_at, even before the scheduler transition it
+ALTER TABLE news
+    ADD COLUMN publish_at   TIMESTAMPTZ NULL,
+    ADD COLUMN unpublish_at TIMESTAMPTZ NULL,
+    ADD CONSTRAINT chk_news_schedule CHECK (publish_at IS NULL OR unpublish_at IS NULL OR unpublish_at > publish_at);
+
+CREATE INDEX idx_news_publish_at ON news (publish_at) WHERE publish_at IS NOT NULL;
+CREATE INDEX idx_news_unpublish_at ON news (unpublish_at) WHERE unpublish_at IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS news_revisions;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,27 @@
+-- Current revision of news, incremented by every update so concurrent updates get distinct revision numbers
+ALTER TABLE news
+    ADD COLUMN revision INT NOT NULL DEFAULT 1;
+
+-- Snapshot of news content after creation and after every update
+CREATE TABLE news_revisions
+(
+    id            BIGSERIAL PRIMARY KEY,
+    news_id       INT         NOT NULL REFERENCES news (id) ON DELETE CASCADE,
+    revision      INT         NOT NULL,
+    title         TEXT        NOT NULL,
+    content       TEXT        NOT NULL,
+    status        INT         NOT NULL,
+    publish_at    TIMESTAMPTZ NULL,
+    unpublish_at  TIMESTAMPTZ NULL,
+    -- revision whose content was restored by this one, NULL for regular update
+    restored_from INT         NULL,
+    -- subject of the token which made the change, NULL while authentication is disabled
+    revised_by    TEXT        NULL,
+    revised_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT uq_news_revisions_revision UNIQUE (news_id, revision)
+);
+
+-- History of existing news start from its current content
+INSERT INTO news_revisions (news_id, revision, title, content, status, publish_at, unpublish_at, revised_by, revised_at)
+SELECT id, revision, title, content, status, publish_at, unpublish_at, created_by, updated_at
+FROM news;
@@ -0,0 +1,12 @@
+package auth
+
+import "errors"
+
+// ErrUnauthenticated is returned when request carry no credential
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// ErrInvalidToken is returned when token is malformed, wrongly signed, expired or not meant for this service
+var ErrInvalidToken = errors.New("invalid token")
+
+// ErrForbidden is returned when principal is known but not allowed to do the action
+var ErrForbidden = errors.New("forbidden")
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+// Guard authenticate request by bearer token and authorize it by role
+type Guard struct {
+	verifier      *Verifier
+	anonymousRead bool
+}
+
+// NewGuard return guard using verifier, nil verifier disable authentication so every request pass without principal.
+// When anonymousRead is true, routes requiring only ROLE_READER also accept request without token, as reader without subject
+func NewGuard(verifier *Verifier, anonymousRead bool) *Guard {
+	return &Guard{
+		verifier:      verifier,
+		anonymousRead: anonymousRead,
+	}
+}
+
+// Require return middleware rejecting request without valid token (401) or whose role is below role (403).
+// Principal of accepted request is put on request context, see PrincipalFromContext
+func (g *Guard) Require(role Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if g.verifier == nil {
+			ctx.Next()
+			return
+		}
+
+		token, ok := bearerToken(ctx.GetHeader("Authorization"))
+		if !ok {
+			if role == ROLE_READER && g.anonymousRead {
+				ctx.Request = ctx.Request.WithContext(WithPrincipal(ctx.Request.Context(), Principal{Role: ROLE_READER}))
+				ctx.Next()
+				return
+			}
+
+			abortUnauthorized(ctx, "Authentication Required, use Authorization: Bearer <token> header")
+			return
+		}
+
+		principal, err := g.verifier.Verify(token)
+		if err != nil {
+			logger.Error(response.InternalError{
+				Type:         "Auth",
+				Name:         "Guard",
+				FunctionName: "Require",
+				Description:  "rejected token",
+				Trace:        err,
+			}.Error())
+
+			abortUnauthorized(ctx, "Invalid or Expired Token")
+			return
+		}
+
+		if !principal.Role.Allows(role) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, response.ErrorResponse{
+				Success: false,
+				Message: "Forbidden, " + string(role) + " Role is Required",
+				Type:    0,
+				Data:    nil,
+			})
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(WithPrincipal(ctx.Request.Context(), principal))
+		ctx.Next()
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(header[len(prefix):])
+	return token, token != ""
+}
+
+func abortUnauthorized(ctx *gin.Context, message string) {
+	ctx.Header("WWW-Authenticate", `Bearer realm="bareksa"`)
+	ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.ErrorResponse{
+		Success: false,
+		Message: message,
+		Type:    0,
+		Data:    nil,
+	})
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_Require(t *testing.T) {
+	verifier, err := NewVerifier(VerifierOptions{HMACSecret: testHMACSecret})
+	if err != nil {
+		t.Fatal("Failed Creating Verifier")
+	}
+
+	tokenFor := func(role string) string {
+		return "Bearer " + signTestToken(t, "HS256", testHMACSecret, map[string]interface{}{
+			"sub":  "user-1",
+			"role": role,
+			"exp":  time.Now().Add(time.Minute).Unix(),
+		})
+	}
+
+	testcases := []struct {
+		name           string
+		guard          *Guard
+		required       Role
+		authorization  string
+		mustReturnCode int
+		mustPrincipal  string
+	}{
+		{
+			name:           "Success - Authentication Disabled",
+			guard:          NewGuard(nil, false),
+			required:       ROLE_ADMIN,
+			mustReturnCode: http.StatusOK,
+		},
+		{
+			name:           "Success - Anonymous Read",
+			guard:          NewGuard(verifier, true),
+			required:       ROLE_READER,
+			mustReturnCode: http.StatusOK,
+			mustPrincipal:  ":reader",
+		},
+		{
+			name:           "Success - Higher Role",
+			guard:          NewGuard(verifier, false),
+			required:       ROLE_WRITER,
+			authorization:  tokenFor("editor"),
+			mustReturnCode: http.StatusOK,
+			mustPrincipal:  "user-1:editor",
+		},
+		{
+			name:           "Failed - Anonymous Write",
+			guard:          NewGuard(verifier, true),
+			required:       ROLE_WRITER,
+			mustReturnCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "Failed - Anonymous Read Disabled",
+			guard:          NewGuard(verifier, false),
+			required:       ROLE_READER,
+			mustReturnCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "Failed - Invalid Token On Anonymous Read",
+			guard:          NewGuard(verifier, true),
+			required:       ROLE_READER,
+			authorization:  "Bearer invalid",
+			mustReturnCode: http.StatusUnauthorized,
+		},
+		{
+			name:           "Failed - Lower Role",
+			guard:          NewGuard(verifier, false),
+			required:       ROLE_EDITOR,
+			authorization:  tokenFor("writer"),
+			mustReturnCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+
+			var gotPrincipal string
+			router := gin.New()
+			router.GET("/", tc.guard.Require(tc.required), func(ctx *gin.Context) {
+				if principal, ok := PrincipalFromContext(ctx.Request.Context()); ok {
+					gotPrincipal = fmt.Sprintf("%s:%s", principal.Subject, principal.Role)
+				}
+				ctx.Status(http.StatusOK)
+			})
+			router.ServeHTTP(w, req)
+
+			if w.Code != tc.mustReturnCode || gotPrincipal != tc.mustPrincipal {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Require",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got code %d principal %q, expected code %d principal %q", w.Code, gotPrincipal, tc.mustReturnCode, tc.mustPrincipal),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"strings"
+	"time"
+)
+
+// Supported signing algorithms, "none" and every other algorithm are rejected
+const JWT_ALG_HS256 = "HS256"
+const JWT_ALG_HS384 = "HS384"
+const JWT_ALG_HS512 = "HS512"
+const JWT_ALG_RS256 = "RS256"
+const JWT_ALG_RS384 = "RS384"
+const JWT_ALG_RS512 = "RS512"
+
+var jwtHashes = map[string]crypto.Hash{
+	JWT_ALG_HS256: crypto.SHA256,
+	JWT_ALG_HS384: crypto.SHA384,
+	JWT_ALG_HS512: crypto.SHA512,
+	JWT_ALG_RS256: crypto.SHA256,
+	JWT_ALG_RS384: crypto.SHA384,
+	JWT_ALG_RS512: crypto.SHA512,
+}
+
+type VerifierOptions struct {
+	// HMACSecret verify HS256, HS384 and HS512 tokens
+	HMACSecret []byte
+
+	// RSAPublicKey verify RS256, RS384 and RS512 tokens
+	RSAPublicKey *rsa.PublicKey
+
+	// Issuer and Audience are checked against "iss" and "aud" claims when not empty
+	Issuer   string
+	Audience string
+
+	// Leeway tolerate clock skew on "exp" and "nbf" claims
+	Leeway time.Duration
+}
+
+// Verifier validate signed JWT and turn its claims into Principal.
+// Algorithm is only accepted when its key is configured, so RSA public key can not be used as HMAC secret
+type Verifier struct {
+	options VerifierOptions
+	now     func() time.Time
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string   `json:"sub"`
+	Role      Role     `json:"role"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+}
+
+// audience is "aud" claim, which can be single string or list of string
+type audience []string
+
+func (a *audience) UnmarshalJSON(raw []byte) error {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(raw, &multiple); err != nil {
+		return err
+	}
+
+	*a = multiple
+	return nil
+}
+
+func (a audience) contains(target string) bool {
+	for _, v := range a {
+		if v == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func NewVerifier(options VerifierOptions) (*Verifier, error) {
+	if len(options.HMACSecret) == 0 && options.RSAPublicKey == nil {
+		return nil, response.InternalError{
+			Type:         "Auth",
+			Name:         "Verifier",
+			FunctionName: "NewVerifier",
+			Description:  "HMAC secret or RSA public key is required",
+			Trace:        nil,
+		}.Error()
+	}
+
+	return &Verifier{
+		options: options,
+		now:     time.Now,
+	}, nil
+}
+
+// ParseRSAPublicKey read PEM encoded PKIX ("PUBLIC KEY") or PKCS#1 ("RSA PUBLIC KEY") RSA public key
+func ParseRSAPublicKey(raw []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key is %T, not RSA", key)
+	}
+
+	return rsaKey, nil
+}
+
+// Verify check token signature and claims, every failure is ErrInvalidToken with the reason as trace.
+// Token must carry "sub", known "role" and "exp"
+func (v *Verifier) Verify(token string) (Principal, error) {
+	principal, err := v.verify(token)
+	if err != nil {
+		return Principal{}, response.InternalError{
+			Type:         "Auth",
+			Name:         "Verifier",
+			FunctionName: "Verify",
+			Description:  ErrInvalidToken.Error(),
+			Trace:        fmt.Errorf("%w: %v", ErrInvalidToken, err),
+		}.Error()
+	}
+
+	return principal, nil
+}
+
+func (v *Verifier) verify(token string) (Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Principal{}, fmt.Errorf("token must have 3 parts, got %d", len(parts))
+	}
+
+	var header jwtHeader
+	err := decodeSegment(parts[0], &header)
+	if err != nil {
+		return Principal{}, fmt.Errorf("failed decode header, %v", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Principal{}, fmt.Errorf("failed decode signature, %v", err)
+	}
+
+	err = v.verifySignature(header.Alg, parts[0]+"."+parts[1], signature)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	var claims jwtClaims
+	err = decodeSegment(parts[1], &claims)
+	if err != nil {
+		return Principal{}, fmt.Errorf("failed decode claims, %v", err)
+	}
+
+	err = v.verifyClaims(claims)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	return Principal{
+		Subject: claims.Subject,
+		Role:    claims.Role,
+	}, nil
+}
+
+func (v *Verifier) verifySignature(alg, signed string, signature []byte) error {
+	hash, ok := jwtHashes[alg]
+	if !ok {
+		return fmt.Errorf("unsupported alg %q", alg)
+	}
+
+	switch alg {
+	case JWT_ALG_HS256, JWT_ALG_HS384, JWT_ALG_HS512:
+		if len(v.options.HMACSecret) == 0 {
+			return fmt.Errorf("alg %s is not accepted, HMAC secret is not configured", alg)
+		}
+
+		mac := hmac.New(hash.New, v.options.HMACSecret)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return fmt.Errorf("signature mismatch")
+		}
+	default:
+		if v.options.RSAPublicKey == nil {
+			return fmt.Errorf("alg %s is not accepted, RSA public key is not configured", alg)
+		}
+
+		h := hash.New()
+		h.Write([]byte(signed))
+
+		err := rsa.VerifyPKCS1v15(v.options.RSAPublicKey, hash, h.Sum(nil), signature)
+		if err != nil {
+			return fmt.Errorf("signature mismatch, %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) verifyClaims(claims jwtClaims) error {
+	now := v.now()
+	leeway := v.options.Leeway
+
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("exp claim is required")
+	}
+
+	if now.After(numericDate(*claims.ExpiresAt).Add(leeway)) {
+		return fmt.Errorf("token expired")
+	}
+
+	if claims.NotBefore != nil && now.Add(leeway).Before(numericDate(*claims.NotBefore)) {
+		return fmt.Errorf("token not valid yet")
+	}
+
+	if v.options.Issuer != "" && claims.Issuer != v.options.Issuer {
+		return fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+
+	if v.options.Audience != "" && !claims.Audience.contains(v.options.Audience) {
+		return fmt.Errorf("token is not meant for audience %q", v.options.Audience)
+	}
+
+	if claims.Subject == "" {
+		return fmt.Errorf("sub claim is required")
+	}
+
+	if !claims.Role.Valid() {
+		return fmt.Errorf("unknown role %q", claims.Role)
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, dest interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, dest)
+}
+
+// numericDate convert JWT NumericDate, seconds since epoch possibly with fraction, to time
+func numericDate(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
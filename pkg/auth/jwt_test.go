@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var testHMACSecret = []byte("test-secret")
+
+// signTestToken build token signed with HS256 when key is []byte, RS256 when key is *rsa.PrivateKey, and unsigned otherwise
+func signTestToken(t *testing.T, alg string, key interface{}, claims map[string]interface{}) string {
+	header, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	switch k := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, k)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(signed))
+
+		var err error
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal("Failed Signing Token")
+		}
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func Test_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal("Failed Generating RSA Key")
+	}
+
+	now := time.Unix(1700000000, 0)
+	validClaims := func(overrides map[string]interface{}) map[string]interface{} {
+		claims := map[string]interface{}{
+			"sub":  "user-1",
+			"role": "writer",
+			"iss":  "bareksa-auth",
+			"aud":  []string{"bareksa-news"},
+			"exp":  now.Add(time.Minute).Unix(),
+		}
+		for k, v := range overrides {
+			claims[k] = v
+		}
+		return claims
+	}
+
+	options := VerifierOptions{
+		HMACSecret:   testHMACSecret,
+		RSAPublicKey: &rsaKey.PublicKey,
+		Issuer:       "bareksa-auth",
+		Audience:     "bareksa-news",
+		Leeway:       5 * time.Second,
+	}
+
+	testcases := []struct {
+		name       string
+		options    VerifierOptions
+		token      string
+		mustReturn Principal
+		mustErr    bool
+	}{
+		{
+			name:       "Success - HS256",
+			options:    options,
+			token:      signTestToken(t, "HS256", testHMACSecret, validClaims(nil)),
+			mustReturn: Principal{Subject: "user-1", Role: ROLE_WRITER},
+		},
+		{
+			name:       "Success - RS256 With Single Audience",
+			options:    options,
+			token:      signTestToken(t, "RS256", rsaKey, validClaims(map[string]interface{}{"aud": "bareksa-news"})),
+			mustReturn: Principal{Subject: "user-1", Role: ROLE_WRITER},
+		},
+		{
+			name:       "Success - Expired Within Leeway",
+			options:    options,
+			token:      signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"exp": now.Add(-time.Second).Unix()})),
+			mustReturn: Principal{Subject: "user-1", Role: ROLE_WRITER},
+		},
+		{
+			name:    "Failed - Wrong Secret",
+			options: options,
+			token:   signTestToken(t, "HS256", []byte("other-secret"), validClaims(nil)),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Alg None",
+			options: options,
+			token:   signTestToken(t, "none", nil, validClaims(nil)),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - HS256 Without HMAC Secret",
+			options: VerifierOptions{RSAPublicKey: &rsaKey.PublicKey},
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(nil)),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Expired",
+			options: options,
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"exp": now.Add(-time.Minute).Unix()})),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - No Expiry",
+			options: options,
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"exp": nil})),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Not Valid Yet",
+			options: options,
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"nbf": now.Add(time.Minute).Unix()})),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Other Issuer",
+			options: options,
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"iss": "other"})),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Other Audience",
+			options: options,
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"aud": "other"})),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Unknown Role",
+			options: options,
+			token:   signTestToken(t, "HS256", testHMACSecret, validClaims(map[string]interface{}{"role": "root"})),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Malformed",
+			options: options,
+			token:   "not-a-token",
+			mustErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			verifier, err := NewVerifier(tc.options)
+			if err != nil {
+				tt.Fatal("Failed Creating Verifier")
+			}
+			verifier.now = func() time.Time { return now }
+
+			res, err := verifier.Verify(tc.token)
+
+			if (tc.mustErr && !errors.Is(err, ErrInvalidToken)) || (!tc.mustErr && err != nil) || !reflect.DeepEqual(res, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Verify",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, err %v", res, tc.mustReturn, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package auth
+
+import "context"
+
+type principalKey struct{}
+
+// Principal is the authenticated caller, Subject is "sub" claim of its token
+type Principal struct {
+	Subject string `json:"sub"`
+	Role    Role   `json:"role"`
+}
+
+// WithPrincipal return ctx carrying principal, usecases read it back with PrincipalFromContext
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext return principal of the request, ok is false only when no Guard ran, ex. authentication disabled.
+// Anonymous read still carry principal with ROLE_READER and empty Subject, so check Subject to tell anonymous caller
+func PrincipalFromContext(ctx context.Context) (principal Principal, ok bool) {
+	principal, ok = ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
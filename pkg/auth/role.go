@@ -0,0 +1,27 @@
+package auth
+
+// Roles are ordered, each role can do everything roles below it can
+const ROLE_READER Role = "reader"
+const ROLE_WRITER Role = "writer"
+const ROLE_EDITOR Role = "editor"
+const ROLE_ADMIN Role = "admin"
+
+var roleRank = map[Role]int{
+	ROLE_READER: 1,
+	ROLE_WRITER: 2,
+	ROLE_EDITOR: 3,
+	ROLE_ADMIN:  4,
+}
+
+type Role string
+
+// Valid report whether role is one of the known roles
+func (r Role) Valid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+// Allows report whether r is required role or above it
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleRank[r] >= roleRank[required]
+}
@@ -0,0 +1,33 @@
+package cache
+
+import "context"
+
+// Backend store cached read results, GetObject return ErrCacheMiss when key is not cached
+type Backend interface {
+	GetObject(ctx context.Context, key string, dest interface{}) (stale bool, err error)
+	SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error
+	InvalidateTags(ctx context.Context, tags ...string) error
+	Stats() StatsSnapshot
+}
+
+// Noop is Backend caching nothing, every read is a miss
+type Noop struct {
+	stats Stats
+}
+
+func (n *Noop) GetObject(ctx context.Context, key string, dest interface{}) (bool, error) {
+	n.stats.Miss()
+	return false, ErrCacheMiss
+}
+
+func (n *Noop) SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error {
+	return nil
+}
+
+func (n *Noop) InvalidateTags(ctx context.Context, tags ...string) error {
+	return nil
+}
+
+func (n *Noop) Stats() StatsSnapshot {
+	return n.stats.Snapshot()
+}
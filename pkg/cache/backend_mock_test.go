@@ -0,0 +1,34 @@
+package cache
+
+import "context"
+
+type MockBackend struct {
+	err         error
+	calls       int
+	invalidated []string
+}
+
+func (mb *MockBackend) GetObject(ctx context.Context, key string, dest interface{}) (bool, error) {
+	mb.calls++
+	return false, mb.err
+}
+
+func (mb *MockBackend) SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error {
+	mb.calls++
+	return mb.err
+}
+
+// InvalidateTags has no miss, ErrCacheMiss set for GetObject is success here
+func (mb *MockBackend) InvalidateTags(ctx context.Context, tags ...string) error {
+	mb.calls++
+	if mb.err != nil && mb.err != ErrCacheMiss {
+		return mb.err
+	}
+
+	mb.invalidated = append(mb.invalidated, tags...)
+	return nil
+}
+
+func (mb *MockBackend) Stats() StatsSnapshot {
+	return StatsSnapshot{}
+}
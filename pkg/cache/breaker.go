@@ -0,0 +1,219 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"github.com/Mufidzz/bareksa-test/pkg/logger"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"sync"
+	"time"
+)
+
+// BREAKER_MAX_PENDING_TAGS bound invalidations kept while backend is bypassed, they are replayed once it recover
+const BREAKER_MAX_PENDING_TAGS = 10000
+
+// Breaker bypass its backend after failureThreshold consecutive failures, so cache outage cost a miss instead of a timeout.
+// After cooldown single call is let through to probe the backend, success close the circuit and failure keep it open.
+// Invalidations while bypassed are kept and replayed on recovery, so entries written before the outage are not served stale
+type Breaker struct {
+	backend          Backend
+	failureThreshold int
+	cooldown         time.Duration
+
+	mu          sync.Mutex
+	failures    int
+	open        bool
+	openedAt    time.Time
+	probing     bool
+	pendingTags map[string]bool
+
+	stats Stats
+	now   func() time.Time
+}
+
+func NewBreaker(backend Backend, failureThreshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{
+		backend:          backend,
+		failureThreshold: failureThreshold,
+		cooldown:         cooldown,
+		pendingTags:      map[string]bool{},
+		now:              time.Now,
+	}
+}
+
+// Trip open the circuit immediately, ex. when backend is already unreachable on start
+func (b *Breaker) Trip() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.open = true
+	b.openedAt = b.now()
+}
+
+// Open report whether backend is currently bypassed
+func (b *Breaker) Open() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.open
+}
+
+func (b *Breaker) GetObject(ctx context.Context, key string, dest interface{}) (bool, error) {
+	if !b.allow() {
+		b.stats.Bypass()
+		return false, ErrCircuitOpen
+	}
+
+	stale, err := b.backend.GetObject(ctx, key, dest)
+	b.record(ctx, err)
+
+	return stale, err
+}
+
+func (b *Breaker) SaveObject(ctx context.Context, key string, value interface{}, tags ...string) error {
+	if !b.allow() {
+		b.stats.Bypass()
+		return ErrCircuitOpen
+	}
+
+	err := b.backend.SaveObject(ctx, key, value, tags...)
+	b.record(ctx, err)
+
+	return err
+}
+
+// InvalidateTags keep the tags for replay when backend is bypassed or fail, so nil is returned in both case
+func (b *Breaker) InvalidateTags(ctx context.Context, tags ...string) error {
+	if !b.allow() {
+		b.stats.Bypass()
+		b.addPendingTags(tags)
+		return nil
+	}
+
+	err := b.backend.InvalidateTags(ctx, tags...)
+	if err != nil {
+		b.addPendingTags(tags)
+	}
+	b.record(ctx, err)
+
+	return nil
+}
+
+func (b *Breaker) Stats() StatsSnapshot {
+	stats := b.backend.Stats()
+	stats.Bypassed = b.stats.Snapshot().Bypassed
+
+	return stats
+}
+
+// allow report whether call may reach backend, once cooldown passed single probe is allowed
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return true
+	}
+
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// record update circuit from call result. Miss and corrupt value are answers of healthy backend,
+// and call failed by cancelled request tell nothing about backend so it leave the circuit as it is
+func (b *Breaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	wasOpen := b.open
+	b.probing = false
+
+	if err != nil && ctx.Err() != nil {
+		b.mu.Unlock()
+		return
+	}
+
+	failed := err != nil && !errors.Is(err, ErrCacheMiss) && !errors.Is(err, ErrCacheCorrupt)
+
+	if failed {
+		b.failures++
+		if b.open || b.failures >= b.failureThreshold {
+			b.open = true
+			b.openedAt = b.now()
+		}
+		tripped := !wasOpen && b.open
+		b.mu.Unlock()
+
+		if tripped {
+			logger.Error(response.InternalError{
+				Type:         "Cache",
+				Name:         "Breaker",
+				FunctionName: "record",
+				Description:  "cache backend bypassed after repeated failures",
+				Trace:        err,
+			}.Error())
+		}
+		return
+	}
+
+	b.failures = 0
+	b.open = false
+	hasPending := len(b.pendingTags) > 0
+	b.mu.Unlock()
+
+	if wasOpen {
+		logger.Error(response.InternalError{
+			Type:         "Cache",
+			Name:         "Breaker",
+			FunctionName: "record",
+			Description:  "cache backend recovered",
+			Trace:        nil,
+		}.Error())
+	}
+
+	if hasPending {
+		b.replayPendingTags()
+	}
+}
+
+func (b *Breaker) addPendingTags(tags []string) {
+	b.mu.Lock()
+	dropped := 0
+	for _, tag := range tags {
+		if len(b.pendingTags) >= BREAKER_MAX_PENDING_TAGS && !b.pendingTags[tag] {
+			dropped++
+			continue
+		}
+
+		b.pendingTags[tag] = true
+	}
+	b.mu.Unlock()
+
+	if dropped > 0 {
+		logger.Error(response.InternalError{
+			Type:         "Cache",
+			Name:         "Breaker",
+			FunctionName: "addPendingTags",
+			Description:  "too many pending invalidations, dropped tags may be served stale until expired",
+			Trace:        dropped,
+		}.Error())
+	}
+}
+
+// replayPendingTags send invalidations kept while bypassed, failed tags are kept for next recovery
+func (b *Breaker) replayPendingTags() {
+	b.mu.Lock()
+	tags := make([]string, 0, len(b.pendingTags))
+	for tag := range b.pendingTags {
+		tags = append(tags, tag)
+	}
+	b.pendingTags = map[string]bool{}
+	b.mu.Unlock()
+
+	err := b.backend.InvalidateTags(context.Background(), tags...)
+	if err != nil {
+		b.addPendingTags(tags)
+	}
+}
@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+type breakerStep struct {
+	// op is "get", "save" or "invalidate"
+	op         string
+	tags       []string
+	backendErr error
+	cancelled  bool
+	advance    time.Duration
+	mustCalled bool
+	mustErrIs  error
+}
+
+func Test_Breaker(t *testing.T) {
+	unavailable := fmt.Errorf("wrapped %w", ErrCacheUnavailable)
+
+	testcases := []struct {
+		name            string
+		steps           []breakerStep
+		mustOpen        bool
+		mustInvalidated []string
+	}{
+		{
+			name: "Success - Miss Not Counted As Failure",
+			steps: []breakerStep{
+				{op: "get", backendErr: ErrCacheMiss, mustCalled: true, mustErrIs: ErrCacheMiss},
+				{op: "get", backendErr: ErrCacheMiss, mustCalled: true, mustErrIs: ErrCacheMiss},
+				{op: "get", backendErr: ErrCacheMiss, mustCalled: true, mustErrIs: ErrCacheMiss},
+			},
+			mustOpen: false,
+		},
+		{
+			name: "Success - Cancelled Request Not Counted As Failure",
+			steps: []breakerStep{
+				{op: "get", backendErr: unavailable, cancelled: true, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, cancelled: true, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, cancelled: true, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+			},
+			mustOpen: false,
+		},
+		{
+			name: "Failed - Cancelled Request Keep Failure Count",
+			steps: []breakerStep{
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, cancelled: true, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", mustCalled: false, mustErrIs: ErrCircuitOpen},
+			},
+			mustOpen: true,
+		},
+		{
+			name: "Failed - Cancelled Probe Keep Open",
+			steps: []breakerStep{
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", advance: time.Minute, backendErr: unavailable, cancelled: true, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", mustCalled: false, mustErrIs: ErrCircuitOpen},
+			},
+			mustOpen: true,
+		},
+		{
+			name: "Success - Success Reset Failure Count",
+			steps: []breakerStep{
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "save", mustCalled: true},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+			},
+			mustOpen: false,
+		},
+		{
+			name: "Failed - Open After Threshold And Bypass",
+			steps: []breakerStep{
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "save", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", mustCalled: false, mustErrIs: ErrCircuitOpen},
+				{op: "save", mustCalled: false, mustErrIs: ErrCircuitOpen},
+				{op: "invalidate", tags: []string{"news:1"}, mustCalled: false},
+			},
+			mustOpen: true,
+		},
+		{
+			name: "Failed - Probe Failure Keep Open",
+			steps: []breakerStep{
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", advance: time.Minute, backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", mustCalled: false, mustErrIs: ErrCircuitOpen},
+			},
+			mustOpen: true,
+		},
+		{
+			name: "Success - Probe Close And Replay Invalidation",
+			steps: []breakerStep{
+				{op: "invalidate", tags: []string{"news:1"}, backendErr: unavailable, mustCalled: true},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "get", backendErr: unavailable, mustCalled: true, mustErrIs: ErrCacheUnavailable},
+				{op: "invalidate", tags: []string{"news:2", "news:list"}, mustCalled: false},
+				{op: "get", advance: time.Minute, backendErr: ErrCacheMiss, mustCalled: true, mustErrIs: ErrCacheMiss},
+				{op: "get", backendErr: ErrCacheMiss, mustCalled: true, mustErrIs: ErrCacheMiss},
+			},
+			mustOpen:        false,
+			mustInvalidated: []string{"news:1", "news:2", "news:list"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			backend := &MockBackend{}
+			now := time.Now()
+
+			b := NewBreaker(backend, 3, 30*time.Second)
+			b.now = func() time.Time { return now }
+
+			for i, step := range tc.steps {
+				now = now.Add(step.advance)
+				backend.err = step.backendErr
+				callsBefore := backend.calls
+
+				ctx, cancel := context.WithCancel(context.Background())
+				if step.cancelled {
+					cancel()
+				}
+
+				var err error
+				switch step.op {
+				case "get":
+					var dest string
+					_, err = b.GetObject(ctx, "A", &dest)
+				case "save":
+					err = b.SaveObject(ctx, "A", "B")
+				case "invalidate":
+					err = b.InvalidateTags(ctx, step.tags...)
+				}
+				cancel()
+
+				called := backend.calls > callsBefore
+				if called != step.mustCalled || (step.mustErrIs == nil && err != nil) || (step.mustErrIs != nil && !errors.Is(err, step.mustErrIs)) {
+					tt.Error(response.InternalTestError{
+						Name:         tt.Name(),
+						FunctionName: "Test_Breaker",
+						Description:  "Testcase run unsuccessfully",
+						Trace:        fmt.Sprintf("step %d, called %v, expected %v, err %v, expected %v", i, called, step.mustCalled, err, step.mustErrIs),
+					}.Error())
+				}
+			}
+
+			sort.Strings(backend.invalidated)
+			if b.Open() != tc.mustOpen || !reflect.DeepEqual(backend.invalidated, tc.mustInvalidated) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Breaker",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("open %v, expected %v, invalidated %v, expected %v", b.Open(), tc.mustOpen, backend.invalidated, tc.mustInvalidated),
+				}.Error())
+			}
+		})
+	}
+}
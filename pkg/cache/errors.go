@@ -0,0 +1,18 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCacheMiss is returned when key is not cached, it is expected and not a failure
+var ErrCacheMiss = errors.New("cache miss")
+
+// ErrCacheUnavailable is returned when cache server can not be reached or fail to answer
+var ErrCacheUnavailable = errors.New("cache unavailable")
+
+// ErrCacheCorrupt is returned when cached value can not be decoded
+var ErrCacheCorrupt = errors.New("cache corrupt")
+
+// ErrCircuitOpen is returned while Breaker bypass its backend, it is ErrCacheUnavailable already reported when circuit opened
+var ErrCircuitOpen = fmt.Errorf("%w: circuit open", ErrCacheUnavailable)
@@ -0,0 +1,46 @@
+package cache
+
+import "sync/atomic"
+
+// Stats count cache reads and failures, safe for concurrent use
+type Stats struct {
+	hits     uint64
+	misses   uint64
+	errors   uint64
+	bypassed uint64
+}
+
+type StatsSnapshot struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	Errors uint64 `json:"errors"`
+
+	// Bypassed count calls skipped while Breaker is open
+	Bypassed uint64 `json:"bypassed"`
+}
+
+func (s *Stats) Hit() {
+	atomic.AddUint64(&s.hits, 1)
+}
+
+func (s *Stats) Miss() {
+	atomic.AddUint64(&s.misses, 1)
+}
+
+func (s *Stats) Error() {
+	atomic.AddUint64(&s.errors, 1)
+}
+
+func (s *Stats) Bypass() {
+	atomic.AddUint64(&s.bypassed, 1)
+}
+
+// Snapshot return current counters
+func (s *Stats) Snapshot() StatsSnapshot {
+	return StatsSnapshot{
+		Hits:     atomic.LoadUint64(&s.hits),
+		Misses:   atomic.LoadUint64(&s.misses),
+		Errors:   atomic.LoadUint64(&s.errors),
+		Bypassed: atomic.LoadUint64(&s.bypassed),
+	}
+}
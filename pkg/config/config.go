@@ -0,0 +1,382 @@
+package config
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"gopkg.in/yaml.v2"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ENV_PREFIX is prepended to every `env` tag, ex. `env:"POSTGRE_MASTER"` is read from BAREKSA_POSTGRE_MASTER
+const ENV_PREFIX = "BAREKSA_"
+
+// ENV_CONFIG_PATH points to the config file when -config flag is not given
+const ENV_CONFIG_PATH = ENV_PREFIX + "CONFIG"
+
+// Cache backends selectable by cache.backend
+const CACHE_BACKEND_REDIS = "redis"
+const CACHE_BACKEND_MEMORY = "memory"
+const CACHE_BACKEND_NONE = "none"
+
+type Config struct {
+	Server  ServerConfig  `json:"server" yaml:"server"`
+	Postgre PostgreConfig `json:"postgre" yaml:"postgre"`
+	Redis   RedisConfig   `json:"redis" yaml:"redis"`
+	Cache   CacheConfig   `json:"cache" yaml:"cache"`
+	Auth    AuthConfig    `json:"auth" yaml:"auth"`
+	News    NewsConfig    `json:"news" yaml:"news"`
+}
+
+type ServerConfig struct {
+	Address string     `json:"address" yaml:"address" env:"SERVER_ADDRESS"`
+	CORS    CORSConfig `json:"cors" yaml:"cors"`
+}
+
+type CORSConfig struct {
+	AllowAllOrigins  bool     `json:"allow_all_origins" yaml:"allow_all_origins" env:"CORS_ALLOW_ALL_ORIGINS"`
+	AllowOrigins     []string `json:"allow_origins" yaml:"allow_origins" env:"CORS_ALLOW_ORIGINS"`
+	AllowMethods     []string `json:"allow_methods" yaml:"allow_methods" env:"CORS_ALLOW_METHODS"`
+	AllowHeaders     []string `json:"allow_headers" yaml:"allow_headers" env:"CORS_ALLOW_HEADERS"`
+	ExposeHeaders    []string `json:"expose_headers" yaml:"expose_headers" env:"CORS_EXPOSE_HEADERS"`
+	AllowCredentials bool     `json:"allow_credentials" yaml:"allow_credentials" env:"CORS_ALLOW_CREDENTIALS"`
+}
+
+type PostgreConfig struct {
+	// Master is a lib/pq connection string, ex. "user=x password=y dbname=z host=localhost sslmode=disable"
+	Master string `json:"master" yaml:"master" env:"POSTGRE_MASTER"`
+
+	// Slaves are read replicas, read only queries are spread across them in round-robin
+	Slaves []string `json:"slaves" yaml:"slaves" env:"POSTGRE_SLAVES"`
+
+	// SlaveRetryInterval is how long a failing slave skipped before receiving reads again
+	SlaveRetryInterval Duration `json:"slave_retry_interval" yaml:"slave_retry_interval" env:"POSTGRE_SLAVE_RETRY_INTERVAL"`
+}
+
+type RedisConfig struct {
+	Addr     string `json:"addr" yaml:"addr" env:"REDIS_ADDR"`
+	Password string `json:"password" yaml:"password" env:"REDIS_PASSWORD"`
+	DB       int    `json:"db" yaml:"db" env:"REDIS_DB"`
+}
+
+type CacheConfig struct {
+	// Backend is CACHE_BACKEND_REDIS, CACHE_BACKEND_MEMORY (in-process, not shared between instances) or CACHE_BACKEND_NONE
+	Backend string `json:"backend" yaml:"backend" env:"CACHE_BACKEND"`
+
+	// MemoryMaxEntries bound memory backend, least recently used entry is evicted first
+	MemoryMaxEntries int `json:"memory_max_entries" yaml:"memory_max_entries" env:"CACHE_MEMORY_MAX_ENTRIES"`
+
+	// BreakerFailures is how many consecutive Redis failures open the circuit, Redis is bypassed while it is open
+	BreakerFailures int `json:"breaker_failures" yaml:"breaker_failures" env:"CACHE_BREAKER_FAILURES"`
+
+	// BreakerCooldown is how long Redis is bypassed before single request probe it
+	BreakerCooldown Duration `json:"breaker_cooldown" yaml:"breaker_cooldown" env:"CACHE_BREAKER_COOLDOWN"`
+}
+
+type AuthConfig struct {
+	// Enabled require bearer token on routes, every route is open when it is false
+	Enabled bool `json:"enabled" yaml:"enabled" env:"AUTH_ENABLED"`
+
+	// HMACSecret verify HS256, HS384 and HS512 tokens
+	HMACSecret string `json:"hmac_secret" yaml:"hmac_secret" env:"AUTH_HMAC_SECRET"`
+
+	// RSAPublicKeyFile is path of PEM encoded public key verifying RS256, RS384 and RS512 tokens
+	RSAPublicKeyFile string `json:"rsa_public_key_file" yaml:"rsa_public_key_file" env:"AUTH_RSA_PUBLIC_KEY_FILE"`
+
+	// Issuer and Audience are checked against "iss" and "aud" token claims when not empty
+	Issuer   string `json:"issuer" yaml:"issuer" env:"AUTH_ISSUER"`
+	Audience string `json:"audience" yaml:"audience" env:"AUTH_AUDIENCE"`
+
+	// Leeway tolerate clock skew between token issuer and this service
+	Leeway Duration `json:"leeway" yaml:"leeway" env:"AUTH_LEEWAY"`
+
+	// AnonymousRead let request without token use reader routes
+	AnonymousRead bool `json:"anonymous_read" yaml:"anonymous_read" env:"AUTH_ANONYMOUS_READ"`
+}
+
+type NewsConfig struct {
+	// DeletedRetention is how long soft deleted news can be restored before purge job remove it
+	DeletedRetention Duration `json:"deleted_retention" yaml:"deleted_retention" env:"NEWS_DELETED_RETENTION"`
+
+	// PurgeInterval is how often purge job run, 0 disable it
+	PurgeInterval Duration `json:"purge_interval" yaml:"purge_interval" env:"NEWS_PURGE_INTERVAL"`
+
+	// ScheduleInterval is how often news publish_at and unpublish_at are checked, 0 disable it
+	ScheduleInterval Duration `json:"schedule_interval" yaml:"schedule_interval" env:"NEWS_SCHEDULE_INTERVAL"`
+
+	// LegacyAssocNames keep comma joined topics_name and tags_name on news response for older consumers
+	LegacyAssocNames bool `json:"legacy_assoc_names" yaml:"legacy_assoc_names" env:"NEWS_LEGACY_ASSOC_NAMES"`
+
+	// StaleWhileRevalidate serve cached read past its soft TTL while it is refreshed in background
+	StaleWhileRevalidate bool `json:"stale_while_revalidate" yaml:"stale_while_revalidate" env:"NEWS_STALE_WHILE_REVALIDATE"`
+}
+
+// Default return config used as base before file and environment are applied
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Address: ":4456",
+			CORS: CORSConfig{
+				AllowAllOrigins:  true,
+				AllowMethods:     []string{"PUT", "POST", "GET", "DELETE"},
+				AllowHeaders:     []string{"Origin", "Authorization"},
+				ExposeHeaders:    []string{"Content-Length"},
+				AllowCredentials: true,
+			},
+		},
+		Postgre: PostgreConfig{
+			SlaveRetryInterval: Duration(30 * time.Second),
+		},
+		Redis: RedisConfig{
+			Addr: "localhost:6379",
+			DB:   0,
+		},
+		Cache: CacheConfig{
+			Backend:          CACHE_BACKEND_REDIS,
+			MemoryMaxEntries: 10000,
+			BreakerFailures:  5,
+			BreakerCooldown:  Duration(30 * time.Second),
+		},
+		Auth: AuthConfig{
+			Enabled:       true,
+			Leeway:        Duration(30 * time.Second),
+			AnonymousRead: true,
+		},
+		News: NewsConfig{
+			DeletedRetention: Duration(30 * 24 * time.Hour),
+			PurgeInterval:    Duration(time.Hour),
+			ScheduleInterval: Duration(time.Minute),
+			LegacyAssocNames: true,
+		},
+	}
+}
+
+// Load read config from Default, then override it with file on path (if any), then environment variable, and validate the result
+func Load(path string) (cfg Config, err error) {
+	cfg = Default()
+
+	if path != "" {
+		err = loadFile(path, &cfg)
+		if err != nil {
+			return cfg, err
+		}
+	}
+
+	err = applyEnv(reflect.ValueOf(&cfg).Elem(), os.LookupEnv)
+	if err != nil {
+		return cfg, err
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+func (cfg Config) Validate() error {
+	var problems []string
+
+	if cfg.Server.Address == "" {
+		problems = append(problems, "server.address is required")
+	}
+
+	if cfg.Postgre.Master == "" {
+		problems = append(problems, "postgre.master is required")
+	}
+
+	if cfg.Postgre.SlaveRetryInterval < 0 {
+		problems = append(problems, "postgre durations must not be negative")
+	}
+
+	if cfg.News.DeletedRetention < 0 || cfg.News.PurgeInterval < 0 || cfg.News.ScheduleInterval < 0 {
+		problems = append(problems, "news durations must not be negative")
+	}
+
+	switch cfg.Cache.Backend {
+	case CACHE_BACKEND_REDIS:
+		if cfg.Redis.Addr == "" {
+			problems = append(problems, "redis.addr is required")
+		}
+
+		if cfg.Cache.BreakerFailures < 1 || cfg.Cache.BreakerCooldown <= 0 {
+			problems = append(problems, "cache.breaker_failures and cache.breaker_cooldown must be positive")
+		}
+	case CACHE_BACKEND_MEMORY:
+		if cfg.Cache.MemoryMaxEntries < 1 {
+			problems = append(problems, "cache.memory_max_entries must be positive")
+		}
+	case CACHE_BACKEND_NONE:
+	default:
+		problems = append(problems, fmt.Sprintf("cache.backend must be one of %s, %s or %s", CACHE_BACKEND_REDIS, CACHE_BACKEND_MEMORY, CACHE_BACKEND_NONE))
+	}
+
+	if cfg.Auth.Enabled && cfg.Auth.HMACSecret == "" && cfg.Auth.RSAPublicKeyFile == "" {
+		problems = append(problems, "auth.hmac_secret or auth.rsa_public_key_file is required when auth is enabled")
+	}
+
+	if cfg.Auth.Leeway < 0 {
+		problems = append(problems, "auth.leeway must not be negative")
+	}
+
+	if cfg.Redis.DB < 0 {
+		problems = append(problems, "redis.db must not be negative")
+	}
+
+	if cfg.Server.CORS.AllowAllOrigins && len(cfg.Server.CORS.AllowOrigins) > 0 {
+		problems = append(problems, "server.cors.allow_origins must be empty when allow_all_origins is true")
+	}
+
+	if len(problems) > 0 {
+		return response.InternalError{
+			Type:         "Config",
+			Name:         "Config",
+			FunctionName: "Validate",
+			Description:  "invalid configuration",
+			Trace:        strings.Join(problems, "; "),
+		}.Error()
+	}
+
+	return nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return response.InternalError{
+			Type:         "Config",
+			Name:         "Config",
+			FunctionName: "loadFile",
+			Description:  "failed reading config file",
+			Trace:        err,
+		}.Error()
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(raw, cfg)
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(raw, cfg)
+	default:
+		err = fmt.Errorf("unsupported extension %q, use .json, .yaml or .yml", filepath.Ext(path))
+	}
+
+	if err != nil {
+		return response.InternalError{
+			Type:         "Config",
+			Name:         "Config",
+			FunctionName: "loadFile",
+			Description:  "failed parsing config file",
+			Trace:        err,
+		}.Error()
+	}
+
+	return nil
+}
+
+// applyEnv walk through struct fields and override every field tagged with `env` when the variable is set
+func applyEnv(v reflect.Value, lookup func(string) (string, bool)) error {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field, fieldValue := t.Field(i), v.Field(i)
+
+		if field.Type.Kind() == reflect.Struct {
+			err := applyEnv(fieldValue, lookup)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		key, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+
+		raw, ok := lookup(ENV_PREFIX + key)
+		if !ok {
+			continue
+		}
+
+		err := setValue(fieldValue, raw)
+		if err != nil {
+			return response.InternalError{
+				Type:         "Config",
+				Name:         "Config",
+				FunctionName: "applyEnv",
+				Description:  fmt.Sprintf("invalid value for %s%s", ENV_PREFIX, key),
+				Trace:        err,
+			}.Error()
+		}
+	}
+
+	return nil
+}
+
+func setValue(v reflect.Value, raw string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported slice type %s", v.Type())
+		}
+
+		var values []string
+		for _, s := range strings.Split(raw, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				values = append(values, s)
+			}
+		}
+		v.Set(reflect.ValueOf(values))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// Duration is time.Duration written as string in config file and environment, ex. "30s", "1m30s"
+type Duration time.Duration
+
+func (d Duration) Std() time.Duration {
+	return time.Duration(d)
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
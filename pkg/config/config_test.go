@@ -0,0 +1,201 @@
+package config
+
+import (
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_Load(t *testing.T) {
+	dir := t.TempDir()
+
+	yamlPath := filepath.Join(dir, "config.yaml")
+	err := os.WriteFile(yamlPath, []byte("postgre:\n  master: \"host=yaml\"\n  slaves: [\"host=slave1\", \"host=slave2\"]\n  slave_retry_interval: \"10s\"\nredis:\n  addr: \"redis:6379\"\n  db: 2\nauth:\n  hmac_secret: \"yaml-secret\"\n"), 0600)
+	if err != nil {
+		t.Fatal("Failed Writing YAML Config")
+	}
+
+	jsonPath := filepath.Join(dir, "config.json")
+	err = os.WriteFile(jsonPath, []byte(`{"server": {"address": ":8080"}, "postgre": {"master": "host=json", "slave_retry_interval": "1m"}, "auth": {"hmac_secret": "json-secret"}}`), 0600)
+	if err != nil {
+		t.Fatal("Failed Writing JSON Config")
+	}
+
+	unknownKeyPath := filepath.Join(dir, "unknown.yaml")
+	err = os.WriteFile(unknownKeyPath, []byte("postgre:\n  mastr: \"host=yaml\"\n"), 0600)
+	if err != nil {
+		t.Fatal("Failed Writing YAML Config")
+	}
+
+	testcases := []struct {
+		name       string
+		path       string
+		env        map[string]string
+		mustErr    bool
+		mustReturn func() Config
+	}{
+		{
+			name:    "Failed - No Postgre Connection String",
+			path:    "",
+			mustErr: true,
+		},
+		{
+			name:    "Failed - File Not Found",
+			path:    filepath.Join(dir, "missing.yaml"),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Unsupported Extension",
+			path:    filepath.Join(dir, "config.toml"),
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Unknown YAML Key",
+			path:    unknownKeyPath,
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Invalid Env Value",
+			path:    yamlPath,
+			env:     map[string]string{"BAREKSA_REDIS_DB": "one"},
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Invalid Duration",
+			path:    yamlPath,
+			env:     map[string]string{"BAREKSA_POSTGRE_SLAVE_RETRY_INTERVAL": "ten seconds"},
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Negative Retention",
+			path:    yamlPath,
+			env:     map[string]string{"BAREKSA_NEWS_DELETED_RETENTION": "-1h"},
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Unknown Cache Backend",
+			path:    yamlPath,
+			env:     map[string]string{"BAREKSA_CACHE_BACKEND": "memcached"},
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Memory Cache Without Size",
+			path:    yamlPath,
+			env:     map[string]string{"BAREKSA_CACHE_BACKEND": "memory", "BAREKSA_CACHE_MEMORY_MAX_ENTRIES": "0"},
+			mustErr: true,
+		},
+		{
+			name:    "Failed - Auth Enabled Without Key",
+			path:    yamlPath,
+			env:     map[string]string{"BAREKSA_AUTH_HMAC_SECRET": ""},
+			mustErr: true,
+		},
+		{
+			name: "Success - Auth Disabled Without Key",
+			path: yamlPath,
+			env:  map[string]string{"BAREKSA_AUTH_ENABLED": "false", "BAREKSA_AUTH_HMAC_SECRET": ""},
+			mustReturn: func() Config {
+				cfg := Default()
+				cfg.Postgre.Master = "host=yaml"
+				cfg.Postgre.Slaves = []string{"host=slave1", "host=slave2"}
+				cfg.Postgre.SlaveRetryInterval = Duration(10 * time.Second)
+				cfg.Redis.Addr = "redis:6379"
+				cfg.Redis.DB = 2
+				cfg.Auth.Enabled = false
+				return cfg
+			},
+		},
+		{
+			name: "Success - Memory Cache Without Redis",
+			path: yamlPath,
+			env:  map[string]string{"BAREKSA_CACHE_BACKEND": "memory", "BAREKSA_REDIS_ADDR": ""},
+			mustReturn: func() Config {
+				cfg := Default()
+				cfg.Postgre.Master = "host=yaml"
+				cfg.Postgre.Slaves = []string{"host=slave1", "host=slave2"}
+				cfg.Postgre.SlaveRetryInterval = Duration(10 * time.Second)
+				cfg.Redis.Addr = ""
+				cfg.Redis.DB = 2
+				cfg.Auth.HMACSecret = "yaml-secret"
+				cfg.Cache.Backend = CACHE_BACKEND_MEMORY
+				return cfg
+			},
+		},
+		{
+			name: "Success - YAML File",
+			path: yamlPath,
+			mustReturn: func() Config {
+				cfg := Default()
+				cfg.Postgre.Master = "host=yaml"
+				cfg.Postgre.Slaves = []string{"host=slave1", "host=slave2"}
+				cfg.Postgre.SlaveRetryInterval = Duration(10 * time.Second)
+				cfg.Redis.Addr = "redis:6379"
+				cfg.Redis.DB = 2
+				cfg.Auth.HMACSecret = "yaml-secret"
+				return cfg
+			},
+		},
+		{
+			name: "Success - JSON File",
+			path: jsonPath,
+			mustReturn: func() Config {
+				cfg := Default()
+				cfg.Server.Address = ":8080"
+				cfg.Postgre.Master = "host=json"
+				cfg.Postgre.SlaveRetryInterval = Duration(time.Minute)
+				cfg.Auth.HMACSecret = "json-secret"
+				return cfg
+			},
+		},
+		{
+			name: "Success - Env Override File",
+			path: yamlPath,
+			env: map[string]string{
+				"BAREKSA_POSTGRE_MASTER":         "host=env",
+				"BAREKSA_POSTGRE_SLAVES":         "host=slave3",
+				"BAREKSA_CORS_ALLOW_ALL_ORIGINS": "false",
+				"BAREKSA_CORS_ALLOW_ORIGINS":     "https://a.com, https://b.com",
+			},
+			mustReturn: func() Config {
+				cfg := Default()
+				cfg.Postgre.Master = "host=env"
+				cfg.Postgre.Slaves = []string{"host=slave3"}
+				cfg.Postgre.SlaveRetryInterval = Duration(10 * time.Second)
+				cfg.Redis.Addr = "redis:6379"
+				cfg.Redis.DB = 2
+				cfg.Auth.HMACSecret = "yaml-secret"
+				cfg.Server.CORS.AllowAllOrigins = false
+				cfg.Server.CORS.AllowOrigins = []string{"https://a.com", "https://b.com"}
+				return cfg
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			for k, v := range tc.env {
+				tt.Setenv(k, v)
+			}
+
+			got, err := Load(tc.path)
+
+			var mustReturn Config
+			if tc.mustReturn != nil {
+				mustReturn = tc.mustReturn()
+			}
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (!tc.mustErr && !reflect.DeepEqual(mustReturn, got)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Load",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %+v, expected %+v, mustErr %v, err %v", got, mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package dbutils
+
+import (
+	"fmt"
+	"github.com/lib/pq"
+	"strings"
+)
+
+// Condition is single part of WHERE clause. It is rendered with placeholder numbered after paramCount,
+// so condition never need to know what other part of the query already use
+type Condition interface {
+	build(paramCount int) (sql string, args []interface{})
+}
+
+// Group join conditions with AND or OR, nested group is wrapped in parentheses.
+// Empty group render nothing and is skipped by its parent
+type Group struct {
+	connector  string
+	conditions []Condition
+}
+
+func And(conditions ...Condition) *Group {
+	return (&Group{connector: CONNECTOR_AND}).Add(conditions...)
+}
+
+func Or(conditions ...Condition) *Group {
+	return (&Group{connector: CONNECTOR_OR}).Add(conditions...)
+}
+
+// Add append conditions to group, nil condition is ignored so optional filter can be added inline
+func (g *Group) Add(conditions ...Condition) *Group {
+	for _, condition := range conditions {
+		if condition == nil {
+			continue
+		}
+
+		if group, ok := condition.(*Group); ok && group == nil {
+			continue
+		}
+
+		g.conditions = append(g.conditions, condition)
+	}
+
+	return g
+}
+
+// Build render group without surrounding parentheses, placeholder start from $paramCount+1
+func (g *Group) Build(paramCount int) (sql string, args []interface{}) {
+	sql, args, _ = g.render(paramCount)
+	return sql, args
+}
+
+// Where render group as WHERE clause with leading space, or empty string when group has no condition
+func (g *Group) Where(paramCount int) (sql string, args []interface{}) {
+	sql, args = g.Build(paramCount)
+	if sql == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf(" WHERE %s", sql), args
+}
+
+func (g *Group) build(paramCount int) (string, []interface{}) {
+	sql, args, parts := g.render(paramCount)
+	if parts > 1 {
+		sql = fmt.Sprintf("(%s)", sql)
+	}
+
+	return sql, args
+}
+
+func (g *Group) render(paramCount int) (sql string, args []interface{}, parts int) {
+	var rendered []string
+
+	for _, condition := range g.conditions {
+		part, partArgs := condition.build(paramCount + len(args))
+		if part == "" {
+			continue
+		}
+
+		rendered = append(rendered, part)
+		args = append(args, partArgs...)
+	}
+
+	return strings.Join(rendered, fmt.Sprintf(" %s ", g.connector)), args, len(rendered)
+}
+
+type comparison struct {
+	column     string
+	comparator string
+	value      interface{}
+}
+
+func (c comparison) build(paramCount int) (string, []interface{}) {
+	return fmt.Sprintf("%s %s $%d", c.column, c.comparator, paramCount+1), []interface{}{c.value}
+}
+
+func Equal(column string, value interface{}) Condition {
+	return comparison{column: column, comparator: COMPARATOR_EQUAL, value: value}
+}
+
+func NotEqual(column string, value interface{}) Condition {
+	return comparison{column: column, comparator: COMPARATOR_NOT_EQUAL, value: value}
+}
+
+func Less(column string, value interface{}) Condition {
+	return comparison{column: column, comparator: COMPARATOR_LESS, value: value}
+}
+
+func LessOrEqual(column string, value interface{}) Condition {
+	return comparison{column: column, comparator: COMPARATOR_LESS_OR_EQUAL, value: value}
+}
+
+func Greater(column string, value interface{}) Condition {
+	return comparison{column: column, comparator: COMPARATOR_GREATER, value: value}
+}
+
+func GreaterOrEqual(column string, value interface{}) Condition {
+	return comparison{column: column, comparator: COMPARATOR_GREATER_OR_EQUAL, value: value}
+}
+
+// Like match value as is, caller add the % wildcard
+func Like(column string, value string) Condition {
+	return comparison{column: column, comparator: COMPARATOR_LIKE, value: value}
+}
+
+// ILike is case-insensitive Like
+func ILike(column string, value string) Condition {
+	return comparison{column: column, comparator: COMPARATOR_ILIKE, value: value}
+}
+
+type anyOf struct {
+	column string
+	values interface{}
+}
+
+func (c anyOf) build(paramCount int) (string, []interface{}) {
+	return fmt.Sprintf("%s = ANY($%d)", c.column, paramCount+1), []interface{}{pq.Array(c.values)}
+}
+
+// Any match column against slice sent as single array parameter, values must be slice supported by pq.Array
+func Any(column string, values interface{}) Condition {
+	return anyOf{column: column, values: values}
+}
+
+type in struct {
+	column string
+	values []interface{}
+}
+
+func (c in) build(paramCount int) (string, []interface{}) {
+	if len(c.values) == 0 {
+		// Nothing can match empty list, and "IN ()" is not valid SQL
+		return "FALSE", nil
+	}
+
+	placeholders := make([]string, len(c.values))
+	for i := range c.values {
+		placeholders[i] = fmt.Sprintf("$%d", paramCount+i+1)
+	}
+
+	return fmt.Sprintf("%s %s (%s)", c.column, COMPARATOR_IN, strings.Join(placeholders, ", ")), c.values
+}
+
+// In match column against values with one placeholder each
+func In(column string, values ...interface{}) Condition {
+	return in{column: column, values: values}
+}
+
+type between struct {
+	column   string
+	from, to interface{}
+}
+
+func (c between) build(paramCount int) (string, []interface{}) {
+	return fmt.Sprintf("%s BETWEEN $%d AND $%d", c.column, paramCount+1, paramCount+2), []interface{}{c.from, c.to}
+}
+
+// Between match column inclusively between from and to
+func Between(column string, from, to interface{}) Condition {
+	return between{column: column, from: from, to: to}
+}
+
+type nullCheck struct {
+	column     string
+	comparator string
+}
+
+func (c nullCheck) build(paramCount int) (string, []interface{}) {
+	return fmt.Sprintf("%s %s NULL", c.column, c.comparator), nil
+}
+
+func IsNull(column string) Condition {
+	return nullCheck{column: column, comparator: COMPARATOR_IS}
+}
+
+func IsNotNull(column string) Condition {
+	return nullCheck{column: column, comparator: COMPARATOR_ISNOT}
+}
+
+type not struct {
+	condition Condition
+}
+
+func (c not) build(paramCount int) (sql string, args []interface{}) {
+	// Group is rendered bare, NOT add the parentheses itself
+	if group, ok := c.condition.(*Group); ok {
+		sql, args = group.Build(paramCount)
+	} else {
+		sql, args = c.condition.build(paramCount)
+	}
+
+	if sql == "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf("NOT (%s)", sql), args
+}
+
+func Not(condition Condition) Condition {
+	return not{condition: condition}
+}
+
+type raw struct {
+	sql  string
+	args []interface{}
+}
+
+func (c raw) build(paramCount int) (string, []interface{}) {
+	sql := c.sql
+	for i := range c.args {
+		sql = strings.Replace(sql, RAW_PLACEHOLDER, fmt.Sprintf("$%d", paramCount+i+1), 1)
+	}
+
+	return sql, c.args
+}
+
+// Raw is escape hatch for expression the builder does not cover, e.g. row comparison.
+// Each RAW_PLACEHOLDER in sql is numbered in order and bound to args, sql must not contain other ? character
+func Raw(sql string, args ...interface{}) Condition {
+	return raw{sql: sql, args: args}
+}
@@ -0,0 +1,126 @@
+package dbutils
+
+import (
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/lib/pq"
+	"reflect"
+	"testing"
+)
+
+func Test_GroupWhere(t *testing.T) {
+	type inputParam struct {
+		group      *Group
+		paramCount int
+	}
+
+	testcases := []struct {
+		name     string
+		in       inputParam
+		out      string
+		mustArgs []interface{}
+	}{
+		{
+			name: "Empty Group",
+			in:   inputParam{group: And()},
+			out:  "",
+		},
+		{
+			name: "Nil Condition Skipped",
+			in:   inputParam{group: And(nil, Equal("x", 1), (*Group)(nil))},
+			out:  " WHERE x = $1",
+			mustArgs: []interface{}{
+				1,
+			},
+		},
+		{
+			name: "Placeholder Continue From Param Count",
+			in: inputParam{
+				group:      And(Equal("x", 1), Like("y", "%a%")),
+				paramCount: 2,
+			},
+			out:      " WHERE x = $3 AND y LIKE $4",
+			mustArgs: []interface{}{1, "%a%"},
+		},
+		{
+			name: "Column Contain Where Word",
+			in: inputParam{
+				group: And(Equal("somewhere.id", 1), IsNull("nowhere")),
+			},
+			out:      " WHERE somewhere.id = $1 AND nowhere IS NULL",
+			mustArgs: []interface{}{1},
+		},
+		{
+			name: "Nested Or Group",
+			in: inputParam{
+				group: And(Equal("status", 2), Or(ILike("title", "%a%"), ILike("content", "%a%")), IsNotNull("published_at")),
+			},
+			out:      " WHERE status = $1 AND (title ILIKE $2 OR content ILIKE $3) AND published_at IS NOT NULL",
+			mustArgs: []interface{}{2, "%a%", "%a%"},
+		},
+		{
+			name: "Single Condition Group Not Wrapped",
+			in: inputParam{
+				group: Or(And(Equal("x", 1)), And()),
+			},
+			out:      " WHERE x = $1",
+			mustArgs: []interface{}{1},
+		},
+		{
+			name: "Not",
+			in: inputParam{
+				group: And(Not(Or(Equal("x", 1), Equal("y", 2))), Not(IsNull("z"))),
+			},
+			out:      " WHERE NOT (x = $1 OR y = $2) AND NOT (z IS NULL)",
+			mustArgs: []interface{}{1, 2},
+		},
+		{
+			name: "In, Any And Between",
+			in: inputParam{
+				group: And(In("x", 1, 2, 3), Any("y", []int{4, 5}), Between("z", 6, 7)),
+			},
+			out:      " WHERE x IN ($1, $2, $3) AND y = ANY($4) AND z BETWEEN $5 AND $6",
+			mustArgs: []interface{}{1, 2, 3, pq.Array([]int{4, 5}), 6, 7},
+		},
+		{
+			name: "Empty In Match Nothing",
+			in: inputParam{
+				group: And(In("x"), Equal("y", 1)),
+			},
+			out:      " WHERE FALSE AND y = $1",
+			mustArgs: []interface{}{1},
+		},
+		{
+			name: "Comparison",
+			in: inputParam{
+				group: And(NotEqual("a", 1), Less("b", 2), LessOrEqual("c", 3), Greater("d", 4), GreaterOrEqual("e", 5)),
+			},
+			out:      " WHERE a <> $1 AND b < $2 AND c <= $3 AND d > $4 AND e >= $5",
+			mustArgs: []interface{}{1, 2, 3, 4, 5},
+		},
+		{
+			name: "Raw",
+			in: inputParam{
+				group:      And(Raw("(a, b) < (?, ?)", 1, 2), Equal("c", 3)),
+				paramCount: 1,
+			},
+			out:      " WHERE (a, b) < ($2, $3) AND c = $4",
+			mustArgs: []interface{}{1, 2, 3},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			res, args := tc.in.group.Where(tc.in.paramCount)
+
+			if res != tc.out || !reflect.DeepEqual(args, tc.mustArgs) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_GroupWhere",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v %v, expected %v %v", res, args, tc.out, tc.mustArgs),
+				}.Error())
+			}
+		})
+	}
+}
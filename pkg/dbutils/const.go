@@ -4,7 +4,22 @@ const CONNECTOR_AND = "AND"
 const CONNECTOR_OR = "OR"
 
 const COMPARATOR_EQUAL = "="
+const COMPARATOR_NOT_EQUAL = "<>"
 const COMPARATOR_LIKE = "LIKE"
+const COMPARATOR_ILIKE = "ILIKE"
 const COMPARATOR_ISNOT = "IS NOT"
 const COMPARATOR_IS = "IS"
 const COMPARATOR_IN = "IN"
+const COMPARATOR_LESS = "<"
+const COMPARATOR_LESS_OR_EQUAL = "<="
+const COMPARATOR_GREATER = ">"
+const COMPARATOR_GREATER_OR_EQUAL = ">="
+
+// RAW_PLACEHOLDER mark parameter position on Raw condition
+const RAW_PLACEHOLDER = "?"
+
+const SORT_ASC = "ASC"
+const SORT_DESC = "DESC"
+
+// SORT_DESC_PREFIX mark field as descending on sort parameter, e.g. -created_at
+const SORT_DESC_PREFIX = "-"
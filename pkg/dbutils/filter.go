@@ -5,10 +5,12 @@ import (
 	"strings"
 )
 
+// Deprecated: AddFilter treat any "where" in str as existing WHERE clause, build conditions with And / Or instead
 func AddFilter(str, connector, key, comparator string, paramCount int) string {
 	return AddCustomFilter(str, connector, key, comparator, fmt.Sprintf("$%d", paramCount))
 }
 
+// Deprecated: AddCustomFilter has the same "where" detection problem as AddFilter, use Raw condition instead
 func AddCustomFilter(str, connector, key, comparator, param string) string {
 	if !strings.Contains(strings.ToLower(str), "where") {
 		str = fmt.Sprintf("%s WHERE %s %s %s", str, key, comparator, param)
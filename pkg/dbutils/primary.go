@@ -0,0 +1,17 @@
+package dbutils
+
+import "context"
+
+type primaryReadKey struct{}
+
+// WithPrimaryRead return ctx whose read queries run on primary database. Use it for reads deciding a write, ex. ownership
+// or status check, so replication lag can not make them see stale row
+func WithPrimaryRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadKey{}, true)
+}
+
+// PrimaryRead report whether read queries of ctx must run on primary database, see WithPrimaryRead
+func PrimaryRead(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryReadKey{}).(bool)
+	return primary
+}
@@ -0,0 +1,45 @@
+package dbutils
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"testing"
+)
+
+func Test_PrimaryRead(t *testing.T) {
+	testcases := []struct {
+		name string
+		ctx  context.Context
+		out  bool
+	}{
+		{
+			name: "Plain Context Read Replica",
+			ctx:  context.Background(),
+			out:  false,
+		},
+		{
+			name: "Marked Context Read Primary",
+			ctx:  WithPrimaryRead(context.Background()),
+			out:  true,
+		},
+		{
+			name: "Derived Context Keep Mark",
+			ctx:  context.WithValue(WithPrimaryRead(context.Background()), struct{}{}, 1),
+			out:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			if out := PrimaryRead(tc.ctx); out != tc.out {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_PrimaryRead",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v", out, tc.out),
+				}.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package dbutils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidSort is returned by AddSort when sort parameter is not acceptable, it is fault of the request
+var ErrInvalidSort = errors.New("invalid sort")
+
+// AddSort append ORDER BY built from comma separated sort fields, e.g. "-created_at,title".
+// Field is looked up on columns so only whitelisted column reach the query, unknown or repeated field is error.
+// tieBreaker field is appended when not already sorted on, so rows with equal value keep stable order between pages
+func AddSort(str, sort string, columns map[string]string, tieBreaker string) (string, error) {
+	var orders []string
+	sorted := map[string]bool{}
+
+	fields := []string{}
+	if sort != "" {
+		fields = strings.Split(sort, ",")
+	}
+
+	if tieBreaker != "" {
+		fields = append(fields, tieBreaker)
+	}
+
+	for i, field := range fields {
+		field = strings.TrimSpace(field)
+
+		direction := SORT_ASC
+		if strings.HasPrefix(field, SORT_DESC_PREFIX) {
+			field, direction = strings.TrimPrefix(field, SORT_DESC_PREFIX), SORT_DESC
+		}
+
+		column, ok := columns[field]
+		if !ok {
+			return str, fmt.Errorf("%w, field %q is not allowed", ErrInvalidSort, field)
+		}
+
+		if sorted[field] {
+			// Tie breaker is the last field, skip it when caller already sort on it
+			if tieBreaker != "" && i == len(fields)-1 {
+				continue
+			}
+
+			return str, fmt.Errorf("%w, field %q is repeated", ErrInvalidSort, field)
+		}
+
+		sorted[field] = true
+		orders = append(orders, fmt.Sprintf("%s %s", column, direction))
+	}
+
+	if len(orders) == 0 {
+		return str, nil
+	}
+
+	return fmt.Sprintf("%s ORDER BY %s", str, strings.Join(orders, ", ")), nil
+}
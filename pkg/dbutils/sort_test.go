@@ -0,0 +1,88 @@
+package dbutils
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"testing"
+)
+
+func Test_AddSort(t *testing.T) {
+	columns := map[string]string{
+		"id":         "news.id",
+		"created_at": "news.created_at",
+		"title":      "news.title",
+	}
+
+	type inputParam struct {
+		sort       string
+		tieBreaker string
+	}
+
+	testcases := []struct {
+		name    string
+		in      inputParam
+		out     string
+		mustErr bool
+	}{
+		{
+			name: "Empty Sort Without Tie Breaker",
+			in:   inputParam{},
+			out:  "A",
+		},
+		{
+			name: "Empty Sort With Tie Breaker",
+			in:   inputParam{tieBreaker: "-id"},
+			out:  "A ORDER BY news.id DESC",
+		},
+		{
+			name: "Multiple Field",
+			in:   inputParam{sort: "-created_at, title", tieBreaker: "id"},
+			out:  "A ORDER BY news.created_at DESC, news.title ASC, news.id ASC",
+		},
+		{
+			name: "Tie Breaker Already Sorted",
+			in:   inputParam{sort: "-id", tieBreaker: "id"},
+			out:  "A ORDER BY news.id DESC",
+		},
+		{
+			name:    "Field Not Whitelisted",
+			in:      inputParam{sort: "content"},
+			out:     "A",
+			mustErr: true,
+		},
+		{
+			name:    "Field Injection",
+			in:      inputParam{sort: "title; DROP TABLE news"},
+			out:     "A",
+			mustErr: true,
+		},
+		{
+			name:    "Field Repeated",
+			in:      inputParam{sort: "title,-title"},
+			out:     "A",
+			mustErr: true,
+		},
+		{
+			name:    "Empty Field",
+			in:      inputParam{sort: "title,"},
+			out:     "A",
+			mustErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			res, err := AddSort("A", tc.in.sort, columns, tc.in.tieBreaker)
+
+			if (tc.mustErr && !errors.Is(err, ErrInvalidSort)) || (!tc.mustErr && err != nil) || res != tc.out {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_AddSort",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.out, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
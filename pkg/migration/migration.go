@@ -0,0 +1,337 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/jmoiron/sqlx"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const MIGRATION_TABLE = "schema_migrations"
+
+// ADVISORY_LOCK_KEY is held for the whole run, so two instances starting together do not apply the same version twice
+const ADVISORY_LOCK_KEY = 44560001
+
+const DIRECTION_UP = "up"
+const DIRECTION_DOWN = "down"
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Version int64
+	Name    string
+	Applied bool
+}
+
+type Migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+func New(db *sqlx.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Load read every <version>_<name>.(up|down).sql file on fsys root, sorted by version.
+// Each version must have both up and down file
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "Load",
+			Description:  "failed read migration directory",
+			Trace:        err,
+		}.Error()
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Migration",
+				Name:         "Migration",
+				FunctionName: "Load",
+				Description:  "invalid migration version",
+				Trace:        fmt.Errorf("file %s, %v", entry.Name(), err),
+			}.Error()
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, response.InternalError{
+				Type:         "Migration",
+				Name:         "Migration",
+				FunctionName: "Load",
+				Description:  "failed read migration file",
+				Trace:        err,
+			}.Error()
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != match[2] {
+			return nil, response.InternalError{
+				Type:         "Migration",
+				Name:         "Migration",
+				FunctionName: "Load",
+				Description:  "duplicate migration version",
+				Trace:        fmt.Sprintf("version %d used by %s and %s", version, migration.Name, match[2]),
+			}.Error()
+		}
+
+		if match[3] == DIRECTION_UP {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, response.InternalError{
+				Type:         "Migration",
+				Name:         "Migration",
+				FunctionName: "Load",
+				Description:  "migration must have both up and down file",
+				Trace:        fmt.Sprintf("version %d %s", migration.Version, migration.Name),
+			}.Error()
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up apply every pending migration in version order, return applied versions
+func (m *Migrator) Up(ctx context.Context) (applied []int64, err error) {
+	err = m.withLock(ctx, func(conn *sqlx.Conn) error {
+		appliedVersions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if appliedVersions[migration.Version] {
+				continue
+			}
+
+			err = m.apply(ctx, conn, migration, DIRECTION_UP)
+			if err != nil {
+				return err
+			}
+
+			applied = append(applied, migration.Version)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down revert latest `steps` applied migrations, return reverted versions
+func (m *Migrator) Down(ctx context.Context, steps int) (reverted []int64, err error) {
+	err = m.withLock(ctx, func(conn *sqlx.Conn) error {
+		appliedVersions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if !appliedVersions[migration.Version] {
+				continue
+			}
+
+			err = m.apply(ctx, conn, migration, DIRECTION_DOWN)
+			if err != nil {
+				return err
+			}
+
+			reverted = append(reverted, migration.Version)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+// Status list every known migration and whether it is applied
+func (m *Migrator) Status(ctx context.Context) (status []Status, err error) {
+	err = m.withLock(ctx, func(conn *sqlx.Conn) error {
+		appliedVersions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status = append(status, Status{
+				Version: migration.Version,
+				Name:    migration.Name,
+				Applied: appliedVersions[migration.Version],
+			})
+		}
+
+		return nil
+	})
+
+	return status, err
+}
+
+// withLock run fn on single connection holding ADVISORY_LOCK_KEY, advisory lock is per session so every statement must use the same connection
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sqlx.Conn) error) error {
+	conn, err := m.db.Connx(ctx)
+	if err != nil {
+		return response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "withLock",
+			Description:  "failed get connection",
+			Trace:        err,
+		}.Error()
+	}
+	defer conn.Close()
+
+	_, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, ADVISORY_LOCK_KEY)
+	if err != nil {
+		return response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "withLock",
+			Description:  "failed acquire advisory lock",
+			Trace:        err,
+		}.Error()
+	}
+
+	defer func() {
+		// Use fresh context, lock must be released even when ctx is cancelled
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, ADVISORY_LOCK_KEY)
+	}()
+
+	_, err = conn.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (version BIGINT PRIMARY KEY, name TEXT NOT NULL, applied_at TIMESTAMPTZ NOT NULL DEFAULT now())`, MIGRATION_TABLE))
+	if err != nil {
+		return response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "withLock",
+			Description:  "failed create migration table",
+			Trace:        err,
+		}.Error()
+	}
+
+	return fn(conn)
+}
+
+func (m *Migrator) appliedVersions(ctx context.Context, conn *sqlx.Conn) (map[int64]bool, error) {
+	var versions []int64
+
+	err := conn.SelectContext(ctx, &versions, fmt.Sprintf(`SELECT version FROM %s`, MIGRATION_TABLE))
+	if err != nil {
+		return nil, response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "appliedVersions",
+			Description:  "failed read applied migration",
+			Trace:        err,
+		}.Error()
+	}
+
+	appliedVersions := map[int64]bool{}
+	for _, version := range versions {
+		appliedVersions[version] = true
+	}
+
+	return appliedVersions, nil
+}
+
+// apply run single migration script and record it on MIGRATION_TABLE in one transaction
+func (m *Migrator) apply(ctx context.Context, conn *sqlx.Conn, migration Migration, direction string) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "apply",
+			Description:  "failed begin transaction",
+			Trace:        err,
+		}.Error()
+	}
+
+	script := migration.Up
+	record, recordArgs := fmt.Sprintf(`INSERT INTO %s (version, name) VALUES ($1, $2)`, MIGRATION_TABLE), []interface{}{migration.Version, migration.Name}
+	if direction == DIRECTION_DOWN {
+		script = migration.Down
+		record, recordArgs = fmt.Sprintf(`DELETE FROM %s WHERE version = $1`, MIGRATION_TABLE), []interface{}{migration.Version}
+	}
+
+	_, err = tx.ExecContext(ctx, script)
+	if err == nil {
+		_, err = tx.ExecContext(ctx, record, recordArgs...)
+	}
+
+	if err != nil {
+		_ = tx.Rollback()
+		return response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "apply",
+			Description:  fmt.Sprintf("failed run %s migration %d_%s", direction, migration.Version, migration.Name),
+			Trace:        err,
+		}.Error()
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return response.InternalError{
+			Type:         "Migration",
+			Name:         "Migration",
+			FunctionName: "apply",
+			Description:  "failed commit transaction",
+			Trace:        err,
+		}.Error()
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"github.com/jmoiron/sqlx"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+var testFS = fstest.MapFS{
+	"0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b ()")},
+	"0002_create_b.down.sql": {Data: []byte("DROP TABLE b")},
+	"0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a ()")},
+	"0001_create_a.down.sql": {Data: []byte("DROP TABLE a")},
+	"README.md":              {Data: []byte("not a migration")},
+}
+
+func Test_Load(t *testing.T) {
+	testcases := []struct {
+		name       string
+		fsys       fstest.MapFS
+		mustErr    bool
+		mustReturn []Migration
+	}{
+		{
+			name: "Failed - Missing Down File",
+			fsys: fstest.MapFS{
+				"0001_create_a.up.sql": {Data: []byte("CREATE TABLE a ()")},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Duplicate Version",
+			fsys: fstest.MapFS{
+				"0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a ()")},
+				"0001_create_a.down.sql": {Data: []byte("DROP TABLE a")},
+				"0001_create_b.up.sql":   {Data: []byte("CREATE TABLE b ()")},
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Sorted By Version",
+			fsys: testFS,
+			mustReturn: []Migration{
+				{Version: 1, Name: "create_a", Up: "CREATE TABLE a ()", Down: "DROP TABLE a"},
+				{Version: 2, Name: "create_b", Up: "CREATE TABLE b ()", Down: "DROP TABLE b"},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			res, err := Load(tc.fsys)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (!tc.mustErr && !reflect.DeepEqual(res, tc.mustReturn)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Load",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_Migrate(t *testing.T) {
+	expectLock := func(mm sqlmock.Sqlmock) {
+		mm.ExpectExec("SELECT pg_advisory_lock").WithArgs(ADVISORY_LOCK_KEY).WillReturnResult(sqlmock.NewResult(0, 0))
+		mm.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	expectUnlock := func(mm sqlmock.Sqlmock) {
+		mm.ExpectExec("SELECT pg_advisory_unlock").WithArgs(ADVISORY_LOCK_KEY).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	testcases := []struct {
+		name       string
+		run        func(m *Migrator) ([]int64, error)
+		mockExp    func(mm sqlmock.Sqlmock)
+		mustErr    bool
+		mustReturn []int64
+	}{
+		{
+			name: "Failed - Lock Error",
+			run: func(m *Migrator) ([]int64, error) {
+				return m.Up(context.Background())
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				mm.ExpectExec("SELECT pg_advisory_lock").WillReturnError(fmt.Errorf("hello"))
+			},
+			mustErr: true,
+		},
+		{
+			name: "Failed - Up Script Error Rolled Back",
+			run: func(m *Migrator) ([]int64, error) {
+				return m.Up(context.Background())
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				expectLock(mm)
+				mm.ExpectQuery("SELECT version FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}))
+				mm.ExpectBegin()
+				mm.ExpectExec("CREATE TABLE a").WillReturnError(fmt.Errorf("hello"))
+				mm.ExpectRollback()
+				expectUnlock(mm)
+			},
+			mustErr: true,
+		},
+		{
+			name: "Success - Up Apply Pending Only",
+			run: func(m *Migrator) ([]int64, error) {
+				return m.Up(context.Background())
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				expectLock(mm)
+				mm.ExpectQuery("SELECT version FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+				mm.ExpectBegin()
+				mm.ExpectExec("CREATE TABLE b").WillReturnResult(sqlmock.NewResult(0, 0))
+				mm.ExpectExec("INSERT INTO schema_migrations").WithArgs(2, "create_b").WillReturnResult(sqlmock.NewResult(0, 1))
+				mm.ExpectCommit()
+				expectUnlock(mm)
+			},
+			mustReturn: []int64{2},
+		},
+		{
+			name: "Success - Down Revert Latest",
+			run: func(m *Migrator) ([]int64, error) {
+				return m.Down(context.Background(), 1)
+			},
+			mockExp: func(mm sqlmock.Sqlmock) {
+				expectLock(mm)
+				mm.ExpectQuery("SELECT version FROM schema_migrations").
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1).AddRow(2))
+				mm.ExpectBegin()
+				mm.ExpectExec("DROP TABLE b").WillReturnResult(sqlmock.NewResult(0, 0))
+				mm.ExpectExec("DELETE FROM schema_migrations").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 1))
+				mm.ExpectCommit()
+				expectUnlock(mm)
+			},
+			mustReturn: []int64{2},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				tt.Fatalf("Error on Initalize sqlmock, trace %v", err)
+			}
+			defer db.Close()
+
+			m, err := New(sqlx.NewDb(db, "sqlmock"), testFS)
+			if err != nil {
+				tt.Fatalf("Error on Initalize migrator, trace %v", err)
+			}
+
+			tc.mockExp(mock)
+			res, err := tc.run(m)
+
+			if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) || (!tc.mustErr && !reflect.DeepEqual(res, tc.mustReturn)) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Migrate",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v, mustErr %v, err %v", res, tc.mustReturn, tc.mustErr, err),
+				}.Error())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Migrate",
+					Description:  "Expectation not met",
+					Trace:        err,
+				}.Error())
+			}
+		})
+	}
+}
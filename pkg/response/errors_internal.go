@@ -10,7 +10,12 @@ type InternalError struct {
 	Trace        interface{}
 }
 
+// Error format the error, error Trace is wrapped so errors.Is and errors.As still see it
 func (in InternalError) Error() error {
+	if trace, ok := in.Trace.(error); ok {
+		return fmt.Errorf("[%s][%s][%s] %s, trace %w", in.Type, in.Name, in.FunctionName, in.Description, trace)
+	}
+
 	return fmt.Errorf("[%s][%s][%s] %s, trace %v", in.Type, in.Name, in.FunctionName, in.Description, in.Trace)
 }
 
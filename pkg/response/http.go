@@ -4,4 +4,7 @@ type SuccessResponse struct {
 	Success bool        `json:"success"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+
+	// Meta hold pagination metadata of list endpoint
+	Meta interface{} `json:"meta,omitempty"`
 }
@@ -0,0 +1,84 @@
+package singleflight
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrPanicked is given to waiting callers when fn panic, the panic itself is raised on the caller running fn
+var ErrPanicked = errors.New("singleflight: function panicked")
+
+type call struct {
+	wg  sync.WaitGroup
+	val interface{}
+	err error
+}
+
+// Group coalesce concurrent calls with the same key, so only one of them run at a time
+type Group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// Do run fn once for every concurrent caller of key, callers arriving while fn is running wait and receive the same result
+func (g *Group) Do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = map[string]*call{}
+	}
+
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+
+	c := &call{err: ErrPanicked}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		c.wg.Done()
+	}()
+
+	c.val, c.err = fn()
+	return c.val, c.err
+}
+
+// Result is what DoChan deliver once fn finished
+type Result struct {
+	Val interface{}
+	Err error
+}
+
+// DoChan is like Do but return channel receiving the result, so caller can stop waiting without stopping fn.
+// Panic of fn is delivered as ErrPanicked instead of raised, since nobody would recover it on the running goroutine
+func (g *Group) DoChan(key string, fn func() (interface{}, error)) <-chan Result {
+	ch := make(chan Result, 1)
+
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				ch <- Result{Err: ErrPanicked}
+			}
+		}()
+
+		val, err := g.Do(key, fn)
+		ch <- Result{Val: val, Err: err}
+	}()
+
+	return ch
+}
+
+// InFlight report whether call for key is running
+func (g *Group) InFlight(key string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	_, ok := g.calls[key]
+	return ok
+}
@@ -0,0 +1,140 @@
+package singleflight
+
+import (
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_Do(t *testing.T) {
+	testcases := []struct {
+		name        string
+		callers     int
+		keys        []string
+		fnErr       error
+		mustRunning int32
+		mustErr     bool
+	}{
+		{
+			name:        "Success - Same Key Coalesced",
+			callers:     10,
+			keys:        []string{"A"},
+			mustRunning: 1,
+		},
+		{
+			name:        "Success - Different Key Run Separately",
+			callers:     10,
+			keys:        []string{"A", "B"},
+			mustRunning: 2,
+		},
+		{
+			name:        "Failed - Error Shared",
+			callers:     10,
+			keys:        []string{"A"},
+			fnErr:       fmt.Errorf("hello"),
+			mustRunning: 1,
+			mustErr:     true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var g Group
+			var running int32
+			release := make(chan struct{})
+
+			var wg sync.WaitGroup
+			results := make(chan error, tc.callers)
+			for i := 0; i < tc.callers; i++ {
+				key := tc.keys[i%len(tc.keys)]
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					val, err := g.Do(key, func() (interface{}, error) {
+						atomic.AddInt32(&running, 1)
+						<-release
+						return key, tc.fnErr
+					})
+					if err == nil && val != key {
+						err = fmt.Errorf("got %v, expected %v", val, key)
+					}
+					results <- err
+				}()
+			}
+
+			// Give every caller time to join the running call before releasing it
+			time.Sleep(50 * time.Millisecond)
+			close(release)
+			wg.Wait()
+			close(results)
+
+			for err := range results {
+				if (tc.mustErr && err == nil) || (!tc.mustErr && err != nil) {
+					tt.Error(response.InternalTestError{
+						Name:         tt.Name(),
+						FunctionName: "Test_Do",
+						Description:  "Testcase run unsuccessfully",
+						Trace:        fmt.Sprintf("mustErr %v, err %v", tc.mustErr, err),
+					}.Error())
+				}
+			}
+
+			if running != tc.mustRunning || g.InFlight("A") {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Do",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("fn run %d times, expected %d", running, tc.mustRunning),
+				}.Error())
+			}
+		})
+	}
+}
+
+func Test_DoChan(t *testing.T) {
+	testcases := []struct {
+		name    string
+		fn      func() (interface{}, error)
+		mustVal interface{}
+		mustErr error
+	}{
+		{
+			name:    "Success - Result Delivered",
+			fn:      func() (interface{}, error) { return "A", nil },
+			mustVal: "A",
+		},
+		{
+			name:    "Failed - Panic Delivered As Error",
+			fn:      func() (interface{}, error) { panic("hello") },
+			mustErr: ErrPanicked,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			var g Group
+
+			select {
+			case res := <-g.DoChan("A", tc.fn):
+				if res.Val != tc.mustVal || res.Err != tc.mustErr || g.InFlight("A") {
+					tt.Error(response.InternalTestError{
+						Name:         tt.Name(),
+						FunctionName: "Test_DoChan",
+						Description:  "Testcase run unsuccessfully",
+						Trace:        fmt.Sprintf("got %v, err %v, expected %v, err %v", res.Val, res.Err, tc.mustVal, tc.mustErr),
+					}.Error())
+				}
+			case <-time.After(time.Second):
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_DoChan",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        "result never delivered",
+				}.Error())
+			}
+		})
+	}
+}
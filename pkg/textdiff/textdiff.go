@@ -0,0 +1,106 @@
+package textdiff
+
+import "strings"
+
+// Operation of diff Line
+const (
+	OP_EQUAL  = "equal"
+	OP_INSERT = "insert"
+	OP_DELETE = "delete"
+)
+
+// MAX_LCS_CELLS bound memory of longest common subsequence table. Changed part larger than it is reported
+// as every old line deleted then every new line inserted
+const MAX_LCS_CELLS = 1 << 22
+
+// Line is single line of diff, OP_DELETE line is only in old text and OP_INSERT line only in new text
+type Line struct {
+	Op   string
+	Text string
+}
+
+// Lines return line based diff turning a into b. Lines kept by the longest common subsequence are OP_EQUAL,
+// on each change deleted lines come before inserted ones
+func Lines(a, b string) []Line {
+	oldLines, newLines := splitLines(a), splitLines(b)
+
+	// Common prefix and suffix are equal whatever the change is, only the middle need the table
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	res := make([]Line, 0, len(oldLines)+len(newLines)-prefix-suffix)
+	res = appendLines(res, OP_EQUAL, oldLines[:prefix])
+	res = appendChange(res, oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])
+	res = appendLines(res, OP_EQUAL, oldLines[len(oldLines)-suffix:])
+
+	return res
+}
+
+func appendChange(res []Line, oldLines, newLines []string) []Line {
+	if len(oldLines) == 0 || len(newLines) == 0 || (len(oldLines)+1)*(len(newLines)+1) > MAX_LCS_CELLS {
+		res = appendLines(res, OP_DELETE, oldLines)
+		return appendLines(res, OP_INSERT, newLines)
+	}
+
+	// lcs[i][j] is length of longest common subsequence of oldLines[i:] and newLines[j:]
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			switch {
+			case oldLines[i] == newLines[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(oldLines) && j < len(newLines) {
+		switch {
+		case oldLines[i] == newLines[j]:
+			res = append(res, Line{Op: OP_EQUAL, Text: oldLines[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			res = append(res, Line{Op: OP_DELETE, Text: oldLines[i]})
+			i++
+		default:
+			res = append(res, Line{Op: OP_INSERT, Text: newLines[j]})
+			j++
+		}
+	}
+
+	res = appendLines(res, OP_DELETE, oldLines[i:])
+	return appendLines(res, OP_INSERT, newLines[j:])
+}
+
+func appendLines(res []Line, op string, lines []string) []Line {
+	for _, l := range lines {
+		res = append(res, Line{Op: op, Text: l})
+	}
+
+	return res
+}
+
+// splitLines split text on "\n", "\r\n" is treated the same. Empty text has no line
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
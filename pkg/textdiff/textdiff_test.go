@@ -0,0 +1,78 @@
+package textdiff
+
+import (
+	"fmt"
+	"github.com/Mufidzz/bareksa-test/pkg/response"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_Lines(t *testing.T) {
+	// Changed part of 3000 x 3000 lines is over MAX_LCS_CELLS, every old line is deleted before every new line inserted
+	largeOld := strings.Split(strings.Repeat("a\n", 3000), "\n")[:3000]
+	largeNew := strings.Split(strings.Repeat("b\n", 3000), "\n")[:3000]
+	largeDiff := appendLines([]Line{{OP_EQUAL, "x"}}, OP_DELETE, largeOld)
+	largeDiff = append(appendLines(largeDiff, OP_INSERT, largeNew), Line{OP_EQUAL, "y"})
+
+	testcases := []struct {
+		name       string
+		a          string
+		b          string
+		mustReturn []Line
+	}{
+		{
+			name:       "Success - Both Empty",
+			a:          "",
+			b:          "",
+			mustReturn: []Line{},
+		},
+		{
+			name:       "Success - Unchanged",
+			a:          "a\nb",
+			b:          "a\r\nb",
+			mustReturn: []Line{{OP_EQUAL, "a"}, {OP_EQUAL, "b"}},
+		},
+		{
+			name:       "Success - From Empty",
+			a:          "",
+			b:          "a\nb",
+			mustReturn: []Line{{OP_INSERT, "a"}, {OP_INSERT, "b"}},
+		},
+		{
+			name:       "Success - Line Changed",
+			a:          "a\nb\nc",
+			b:          "a\nB\nc",
+			mustReturn: []Line{{OP_EQUAL, "a"}, {OP_DELETE, "b"}, {OP_INSERT, "B"}, {OP_EQUAL, "c"}},
+		},
+		{
+			name: "Success - Lines Moved",
+			a:    "a\nb\nc\nd",
+			b:    "b\nc\na\nd\ne",
+			mustReturn: []Line{
+				{OP_DELETE, "a"}, {OP_EQUAL, "b"}, {OP_EQUAL, "c"}, {OP_INSERT, "a"}, {OP_EQUAL, "d"}, {OP_INSERT, "e"},
+			},
+		},
+		{
+			name:       "Success - Change Too Large For Table",
+			a:          "x\n" + strings.Join(largeOld, "\n") + "\ny",
+			b:          "x\n" + strings.Join(largeNew, "\n") + "\ny",
+			mustReturn: largeDiff,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := Lines(tc.a, tc.b)
+
+			if !reflect.DeepEqual(got, tc.mustReturn) {
+				tt.Error(response.InternalTestError{
+					Name:         tt.Name(),
+					FunctionName: "Test_Lines",
+					Description:  "Testcase run unsuccessfully",
+					Trace:        fmt.Sprintf("got %v, expected %v", got, tc.mustReturn),
+				}.Error())
+			}
+		})
+	}
+}
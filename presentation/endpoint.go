@@ -1,23 +1,173 @@
 package presentation
 
+import (
+	"fmt"
+	"time"
+)
+
+// Match mode of multi-value topic and tag filter, empty mean FILTER_MATCH_ANY
+const FILTER_MATCH_ANY = "any"
+const FILTER_MATCH_ALL = "all"
+
+// NewsFilter is sent either encoded on filter query, or as plain query params named by form tag.
+// On plain query repeated status, news_id, topic and tag are bound to the multi-value fields
 type NewsFilter struct {
-	Status int    `json:"status"`
-	Topics []int  `json:"topics"`
-	NewsID int    `json:"news_id"`
-	Title  string `json:"title"`
+	Status int    `json:"status" form:"-"`
+	Topics []int  `json:"topics" form:"topic"`
+	NewsID int    `json:"news_id" form:"-"`
+	Title  string `json:"title" form:"title"`
+
+	// IncludeDeleted also return soft deleted news, filter Status NEWS_STATUS_DELETED to list deleted news only
+	IncludeDeleted bool `json:"include_deleted,omitempty" form:"include_deleted"`
+
+	// Live return only published news inside its publication window. It is set by usecase for public readers, never by client
+	Live bool `json:"-" form:"-"`
+
+	// Statuses and NewsIDs match any of the values, they are combined with Status and NewsID when both are set
+	Statuses []int `json:"statuses,omitempty" form:"status"`
+	NewsIDs  []int `json:"news_ids,omitempty" form:"news_id"`
+
+	Tags        []int  `json:"tags,omitempty" form:"tag"`
+	TopicsMatch string `json:"topics_match,omitempty" form:"topics_match"`
+	TagsMatch   string `json:"tags_match,omitempty" form:"tags_match"`
+
+	// Authors match news with any of the authors, or with every one of them when AuthorsMatch is FILTER_MATCH_ALL
+	Authors      []int  `json:"authors,omitempty" form:"author"`
+	AuthorsMatch string `json:"authors_match,omitempty" form:"authors_match"`
+
+	// Date ranges are inclusive, either end can be left open. Plain query take RFC3339 time
+	CreatedFrom *time.Time `json:"created_from,omitempty" form:"created_from"`
+	CreatedTo   *time.Time `json:"created_to,omitempty" form:"created_to"`
+	UpdatedFrom *time.Time `json:"updated_from,omitempty" form:"updated_from"`
+	UpdatedTo   *time.Time `json:"updated_to,omitempty" form:"updated_to"`
+}
+
+// Validate check statuses, match modes and date ranges, nil filter is valid
+func (f *NewsFilter) Validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Status != 0 && NewsStatusName(f.Status) == "" {
+		return fmt.Errorf("status %d is unknown", f.Status)
+	}
+
+	for _, status := range f.Statuses {
+		if NewsStatusName(status) == "" {
+			return fmt.Errorf("status %d is unknown", status)
+		}
+	}
+
+	for name, match := range map[string]string{"topics_match": f.TopicsMatch, "tags_match": f.TagsMatch, "authors_match": f.AuthorsMatch} {
+		if match != "" && match != FILTER_MATCH_ANY && match != FILTER_MATCH_ALL {
+			return fmt.Errorf("%s must be %q or %q, got %q", name, FILTER_MATCH_ANY, FILTER_MATCH_ALL, match)
+		}
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedFrom.After(*f.CreatedTo) {
+		return fmt.Errorf("created_from must not be after created_to")
+	}
+
+	if f.UpdatedFrom != nil && f.UpdatedTo != nil && f.UpdatedFrom.After(*f.UpdatedTo) {
+		return fmt.Errorf("updated_from must not be after updated_to")
+	}
+
+	return nil
+}
+
+// ShowDeleted tell whether soft deleted news should be returned
+func (f *NewsFilter) ShowDeleted() bool {
+	if f == nil {
+		return false
+	}
+
+	if f.IncludeDeleted || f.Status == NEWS_STATUS_DELETED {
+		return true
+	}
+
+	for _, status := range f.Statuses {
+		if status == NEWS_STATUS_DELETED {
+			return true
+		}
+	}
+
+	return false
 }
 
 type NewsTopicFilter struct {
-	Name        string `json:"name"`
-	NewsTopicID int    `json:"news_topic_id"`
+	Name        string `json:"name" form:"name"`
+	NewsTopicID int    `json:"news_topic_id" form:"id"`
 }
 
 type NewsTagsFilter struct {
-	Name      string `json:"name"`
-	NewsTagID int    `json:"news_tag_id"`
+	Name      string `json:"name" form:"name"`
+	NewsTagID int    `json:"news_tag_id" form:"id"`
 }
 
+type NewsAuthorsFilter struct {
+	Name         string `json:"name" form:"name"`
+	NewsAuthorID int    `json:"news_author_id" form:"id"`
+}
+
+// Pagination is sent either encoded on pagination query, or as plain limit, offset and cursor query params
 type Pagination struct {
-	Offset int64 `json:"offset"`
-	Count  int64 `json:"count"`
+	Offset int64 `json:"offset" form:"offset"`
+	Count  int64 `json:"count" form:"limit"`
+
+	// Cursor is opaque NewsCursor from previous page next_cursor or prev_cursor, Offset is ignored when it is set
+	Cursor string `json:"cursor,omitempty" form:"cursor"`
+
+	// Position is Cursor decoded and validated by usecase, repository read keyset page from it
+	Position *NewsCursor `json:"-" form:"-"`
+}
+
+const CURSOR_DIRECTION_NEXT = "next"
+const CURSOR_DIRECTION_PREV = "prev"
+
+// NewsCursor is keyset position on (created_at, id), encoded with urlutils.EncodeStruct before given to client
+type NewsCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int64     `json:"id"`
+	Direction string    `json:"direction"`
+}
+
+// Validate check cursor point to news in known direction, decoded garbage would otherwise page from zero position
+func (c *NewsCursor) Validate() error {
+	if c.Direction != CURSOR_DIRECTION_NEXT && c.Direction != CURSOR_DIRECTION_PREV {
+		return fmt.Errorf("direction must be %q or %q, got %q", CURSOR_DIRECTION_NEXT, CURSOR_DIRECTION_PREV, c.Direction)
+	}
+
+	if c.CreatedAt.IsZero() || c.ID <= 0 {
+		return fmt.Errorf("cursor does not point to news")
+	}
+
+	return nil
+}
+
+// PaginationMeta describe page returned by list endpoint, Count is number of returned items.
+// On cursor pagination Total count items from the cursor onward in its direction,
+// not the whole list, so client should only rely on HasMore there
+type PaginationMeta struct {
+	Total   int64 `json:"total"`
+	Offset  int64 `json:"offset"`
+	Count   int64 `json:"count"`
+	HasMore bool  `json:"has_more"`
+
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+// NewPaginationMeta build PaginationMeta for page of returned items out of total, nil pagination mean every item is returned
+func NewPaginationMeta(pagination *Pagination, returned int, total int64) PaginationMeta {
+	var offset int64
+	if pagination != nil && pagination.Cursor == "" {
+		offset = pagination.Offset
+	}
+
+	return PaginationMeta{
+		Total:   total,
+		Offset:  offset,
+		Count:   int64(returned),
+		HasMore: offset+int64(returned) < total,
+	}
 }
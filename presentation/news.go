@@ -1,34 +1,150 @@
 package presentation
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
-// Constant for News Status
+// Constant for News Status, changes between them follow the editorial workflow, see NEWS_TRANSITION_SUBMIT
 const NEWS_STATUS_DRAFT = 1
 const NEWS_STATUS_PUBLISHED = 2
 const NEWS_STATUS_DELETED = 3
+const NEWS_STATUS_IN_REVIEW = 4
+const NEWS_STATUS_APPROVED = 5
+const NEWS_STATUS_ARCHIVED = 6
+
+var newsStatusNames = map[int]string{
+	NEWS_STATUS_DRAFT:     "draft",
+	NEWS_STATUS_PUBLISHED: "published",
+	NEWS_STATUS_DELETED:   "deleted",
+	NEWS_STATUS_IN_REVIEW: "in_review",
+	NEWS_STATUS_APPROVED:  "approved",
+	NEWS_STATUS_ARCHIVED:  "archived",
+}
+
+// NewsStatusName return name of the status, empty for unknown status
+func NewsStatusName(status int) string {
+	return newsStatusNames[status]
+}
 
 type GetNewsResponse struct {
-	ID         int64     `db:"id" json:"id"`
-	CreatedAt  time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt  time.Time `db:"updated_at" json:"updated_at"`
-	Title      string    `db:"title" json:"title"`
-	Content    string    `db:"content" json:"content"`
-	TopicsName string    `db:"topics_name" json:"topics_name"`
-	TagsName   string    `db:"tags_name" json:"tags_name"`
-	Status     int       `db:"status" json:"status"`
+	ID        int64     `db:"id" json:"id"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	Title     string    `db:"title" json:"title"`
+	Content   string    `db:"content" json:"content"`
+	Status    int       `db:"status" json:"status"`
+
+	Topics NewsAssocItems `db:"topics" json:"topics"`
+	Tags   NewsAssocItems `db:"tags" json:"tags"`
+
+	// Authors are the byline, in the order they were assigned
+	Authors NewsAssocItems `db:"authors" json:"authors"`
+
+	// TopicsName and TagsName are comma joined names kept for older consumers, they are omitted when legacy names are disabled
+	TopicsName string `db:"topics_name" json:"topics_name,omitempty"`
+	TagsName   string `db:"tags_name" json:"tags_name,omitempty"`
+
+	// DeletedAt is set when news is soft deleted, it can be restored until purged
+	DeletedAt *time.Time `db:"deleted_at" json:"deleted_at,omitempty"`
+
+	// CreatedBy is subject of the principal which created the news, empty for news created without authentication
+	CreatedBy *string `db:"created_by" json:"created_by,omitempty"`
+
+	// PublishAt and UnpublishAt are the publication window, see CreateNewsRequest
+	PublishAt   *time.Time `db:"publish_at" json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `db:"unpublish_at" json:"unpublish_at,omitempty"`
+}
+
+type GetNewsListResponse struct {
+	Data []GetNewsResponse `json:"data"`
+	Meta PaginationMeta    `json:"meta"`
+}
+
+// SearchNewsResponse is news matching full-text search, highlights wrap matched words with <mark></mark>
+type SearchNewsResponse struct {
+	GetNewsResponse
+
+	Rank             float64 `db:"rank" json:"rank"`
+	TitleHighlight   string  `db:"title_highlight" json:"title_highlight"`
+	ContentHighlight string  `db:"content_highlight" json:"content_highlight"`
+}
+
+type SearchNewsListResponse struct {
+	Data []SearchNewsResponse `json:"data"`
+	Meta PaginationMeta       `json:"meta"`
+}
+
+// NewsAssocItem is topic or tag attached to news
+type NewsAssocItem struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type NewsAssocItems []NewsAssocItem
+
+// Scan read JSON array aggregated by Postgre, ex. json_agg(json_build_object('id', id, 'name', name))
+func (items *NewsAssocItems) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*items = NewsAssocItems{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, items)
+	case string:
+		return json.Unmarshal([]byte(v), items)
+	}
+
+	return fmt.Errorf("unsupported type %T for NewsAssocItems", src)
 }
 
 type CreateNewsRequest struct {
 	Title   string `db:"title" json:"title"`
 	Content string `db:"content" json:"content"`
-	Status  int    `db:"status" json:"status"`
+
+	// Status of new news is always NEWS_STATUS_DRAFT, empty is accepted as draft and any other status is refused
+	Status int `db:"status" json:"status"`
+
+	// CreatedBy is set from authenticated principal, never from request body
+	CreatedBy string `db:"created_by" json:"-"`
+
+	// PublishAt embargo the news, scheduler publish it once approved and public readers do not see it before.
+	// UnpublishAt take published news down, both are optional
+	PublishAt   *time.Time `db:"publish_at" json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `db:"unpublish_at" json:"unpublish_at,omitempty"`
+
+	// Topics, Tags and Authors are assigned to the news in the same transaction as creation, Authors order is the byline order
+	Topics  []int `db:"-" json:"topics,omitempty"`
+	Tags    []int `db:"-" json:"tags,omitempty"`
+	Authors []int `db:"-" json:"authors,omitempty"`
 }
 
 type UpdateNewsRequest struct {
 	ID      int    `db:"id" json:"id"`
 	Title   string `db:"title" json:"title"`
 	Content string `db:"content" json:"content"`
-	Status  int    `db:"status" json:"status"`
+
+	// Status is never written by update, it can be left empty or hold the current status.
+	// Any other status is refused, status is changed through workflow transitions
+	Status int `db:"status" json:"status"`
+
+	// PublishAt and UnpublishAt replace the publication window, empty clear it
+	PublishAt   *time.Time `db:"publish_at" json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `db:"unpublish_at" json:"unpublish_at,omitempty"`
+
+	// UpdatedBy is set from authenticated principal and recorded on the revision, never from request body
+	UpdatedBy string `db:"updated_by" json:"-"`
+
+	// RestoredFrom is the revision restored by this update, set by usecase on revision restore
+	RestoredFrom int `db:"restored_from" json:"-"`
+}
+
+// ValidateNewsSchedule check unpublish time is after publish time when both are set
+func ValidateNewsSchedule(publishAt, unpublishAt *time.Time) error {
+	if publishAt != nil && unpublishAt != nil && !unpublishAt.After(*publishAt) {
+		return fmt.Errorf("unpublish_at must be after publish_at")
+	}
+
+	return nil
 }
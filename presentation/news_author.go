@@ -0,0 +1,26 @@
+package presentation
+
+// CreateNewsAuthorsAssoc add authors to the news byline in the given order, after authors it already has
+type CreateNewsAuthorsAssoc struct {
+	NewsID       int   `json:"news_id"`
+	NewsAuthorID []int `json:"news_author_id"`
+}
+
+type CreateNewsAuthorsRequest struct {
+	Name string `db:"name"`
+}
+
+type GetNewsAuthorsResponse struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+}
+
+type GetNewsAuthorsListResponse struct {
+	Data []GetNewsAuthorsResponse `json:"data"`
+	Meta PaginationMeta           `json:"meta"`
+}
+
+type UpdateNewsAuthorsRequest struct {
+	ID   int    `db:"id"`
+	Name string `db:"name"`
+}
@@ -0,0 +1,38 @@
+package presentation
+
+import "time"
+
+// NewsRevision is snapshot of news content after its creation or one of its updates
+type NewsRevision struct {
+	ID       int64  `db:"id" json:"id"`
+	NewsID   int    `db:"news_id" json:"news_id"`
+	Revision int    `db:"revision" json:"revision"`
+	Title    string `db:"title" json:"title"`
+	Content  string `db:"content" json:"content"`
+	Status   int    `db:"status" json:"status"`
+
+	PublishAt   *time.Time `db:"publish_at" json:"publish_at,omitempty"`
+	UnpublishAt *time.Time `db:"unpublish_at" json:"unpublish_at,omitempty"`
+
+	// RestoredFrom is the revision whose content was restored by this one, empty for regular update
+	RestoredFrom *int `db:"restored_from" json:"restored_from,omitempty"`
+
+	// RevisedBy is subject of the principal, empty for revision made while authentication is disabled
+	RevisedBy *string   `db:"revised_by" json:"revised_by,omitempty"`
+	RevisedAt time.Time `db:"revised_at" json:"revised_at"`
+}
+
+// DiffLine is single line of line based diff, Op is one of textdiff OP_EQUAL, OP_INSERT or OP_DELETE
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// NewsRevisionDiff is change of title and content made from revision From to revision To
+type NewsRevisionDiff struct {
+	NewsID  int        `json:"news_id"`
+	From    int        `json:"from"`
+	To      int        `json:"to"`
+	Title   []DiffLine `json:"title"`
+	Content []DiffLine `json:"content"`
+}
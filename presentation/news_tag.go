@@ -13,6 +13,11 @@ type GetNewsTagsResponse struct {
 	Name string `db:"name"`
 }
 
+type GetNewsTagsListResponse struct {
+	Data []GetNewsTagsResponse `json:"data"`
+	Meta PaginationMeta        `json:"meta"`
+}
+
 type UpdateNewsTagsRequest struct {
 	ID   int    `db:"id"`
 	Name string `db:"name"`
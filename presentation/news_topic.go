@@ -9,6 +9,11 @@ type GetNewsTopicsResponse struct {
 	Name string `db:"name"`
 }
 
+type GetNewsTopicsListResponse struct {
+	Data []GetNewsTopicsResponse `json:"data"`
+	Meta PaginationMeta          `json:"meta"`
+}
+
 type UpdateNewsTopicsRequest struct {
 	ID   int    `db:"id"`
 	Name string `db:"name"`
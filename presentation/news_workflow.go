@@ -0,0 +1,27 @@
+package presentation
+
+import "time"
+
+// Editorial workflow transitions, each one is served by POST /news/:newsId/<transition>
+const NEWS_TRANSITION_SUBMIT = "submit"
+const NEWS_TRANSITION_REJECT = "reject"
+const NEWS_TRANSITION_APPROVE = "approve"
+const NEWS_TRANSITION_PUBLISH = "publish"
+const NEWS_TRANSITION_UNPUBLISH = "unpublish"
+
+// Scheduled transitions, due when news publish_at or unpublish_at has come
+const NEWS_SCHEDULE_PUBLISH = "publish_at"
+const NEWS_SCHEDULE_UNPUBLISH = "unpublish_at"
+
+// NewsStatusTransition is single recorded workflow transition of news
+type NewsStatusTransition struct {
+	ID         int64  `db:"id" json:"id"`
+	NewsID     int    `db:"news_id" json:"news_id"`
+	Transition string `db:"transition" json:"transition"`
+	FromStatus int    `db:"from_status" json:"from_status"`
+	ToStatus   int    `db:"to_status" json:"to_status"`
+
+	// TransitionedBy is subject of the principal, empty for transition made while authentication is disabled
+	TransitionedBy *string   `db:"transitioned_by" json:"transitioned_by,omitempty"`
+	TransitionedAt time.Time `db:"transitioned_at" json:"transitioned_at"`
+}